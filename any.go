@@ -0,0 +1,334 @@
+package mildtg
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrUnknownFormat is returned by ParseAny when no registered format
+// recognizes the input.
+var ErrUnknownFormat = errors.New("unrecognized date-time format")
+
+// InputFormat is a format that ParseAny can recognize and parse.
+type InputFormat struct {
+	// Name identifies the format in a Registry and in ParseAny results.
+	Name string
+
+	// Detect reports whether s looks like the format. It should be cheap
+	// and need not be exact, since a failed Parse moves on to the next
+	// format that detects s.
+	Detect func(s string) bool
+
+	// Parse parses s using the defaults of p.
+	Parse func(p Parser, s string) (Time, error)
+}
+
+// The formats registered in DefaultRegistry, in the order they are tried.
+var (
+	// DTGInput is a date-time-group as accepted by ParseDTG, such as
+	// "011200Z JAN 24". Ten-digit inputs are left to UnixInput.
+	DTGInput = InputFormat{Name: "dtg", Detect: detectDTG, Parse: Parser.Parse}
+
+	// RFC3339Input is an RFC 3339 time, such as "2024-01-01T12:00:00Z".
+	RFC3339Input = InputFormat{Name: "rfc3339", Detect: detectRFC3339, Parse: parseRFC3339}
+
+	// ISO8601BasicInput is an ISO 8601 time in the basic format, such as
+	// "20240101T120000Z" or "20240101T1200+0100".
+	ISO8601BasicInput = InputFormat{Name: "iso8601-basic", Detect: detectISO8601Basic, Parse: parseISO8601Basic}
+
+	// DayMonthYearInput is a date followed by a time, such as
+	// "01 JAN 2024 1200" or "01 JAN 2024 1200Z".
+	DayMonthYearInput = InputFormat{Name: "day-month-year", Detect: detectDayMonthYear, Parse: parseDayMonthYear}
+
+	// JulianInput is a military Julian date as accepted by ParseJulian,
+	// such as "4032" or "2024032" for February 1, 2024.
+	JulianInput = InputFormat{Name: "julian", Detect: detectJulian, Parse: Parser.ParseJulian}
+
+	// UnixInput is a count of seconds since January 1, 1970 UTC, such as
+	// "1704110400".
+	UnixInput = InputFormat{Name: "unix", Detect: detectUnix, Parse: parseUnix}
+)
+
+// Registry is an ordered set of input formats for ParseAny.
+// It is safe for concurrent use.
+type Registry struct {
+	mu       sync.RWMutex
+	formats  []InputFormat
+	disabled map[string]bool
+}
+
+// DefaultRegistry holds the formats used by ParseAny. Formats may be
+// registered, enabled and disabled at any time.
+var DefaultRegistry = NewRegistry(DTGInput, RFC3339Input, ISO8601BasicInput,
+	DayMonthYearInput, JulianInput, UnixInput)
+
+// NewRegistry returns a Registry with the given formats enabled, tried
+// in the order given.
+func NewRegistry(formats ...InputFormat) *Registry {
+	r := &Registry{disabled: make(map[string]bool)}
+	for _, f := range formats {
+		r.Register(f)
+	}
+
+	return r
+}
+
+// Register adds f to the end of the registry and enables it. A format
+// already registered with the same name is replaced in place.
+func (r *Registry) Register(f InputFormat) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.disabled, f.Name)
+	for i := range r.formats {
+		if r.formats[i].Name == f.Name {
+			r.formats[i] = f
+			return
+		}
+	}
+
+	r.formats = append(r.formats, f)
+}
+
+// Enable enables the named format and reports whether it is registered.
+func (r *Registry) Enable(name string) bool {
+	return r.setDisabled(name, false)
+}
+
+// Disable disables the named format and reports whether it is registered.
+func (r *Registry) Disable(name string) bool {
+	return r.setDisabled(name, true)
+}
+
+// setDisabled marks the named format and reports whether it is registered.
+func (r *Registry) setDisabled(name string, disabled bool) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, f := range r.formats {
+		if f.Name == name {
+			if disabled {
+				r.disabled[name] = true
+			} else {
+				delete(r.disabled, name)
+			}
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// Names returns the names of the enabled formats in the order they are tried.
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var names []string
+	for _, f := range r.formats {
+		if !r.disabled[f.Name] {
+			names = append(names, f.Name)
+		}
+	}
+
+	return names
+}
+
+// Parse parses s with the first enabled format that detects and parses
+// it, using the defaults of p, and returns the name of that format.
+// If formats detect s but none parses it, the error of the first is
+// returned. If none detects s, the error wraps ErrUnknownFormat.
+func (r *Registry) Parse(p Parser, s string) (Time, string, error) {
+	r.mu.RLock()
+	formats := make([]InputFormat, 0, len(r.formats))
+	for _, f := range r.formats {
+		if !r.disabled[f.Name] {
+			formats = append(formats, f)
+		}
+	}
+	r.mu.RUnlock()
+
+	if err := p.validate(s); err != nil {
+		return Time{}, "", err
+	}
+
+	p = p.withReference()
+
+	var first error
+	for _, f := range formats {
+		if !f.Detect(s) {
+			continue
+		}
+
+		t, err := f.Parse(p, s)
+		if err == nil {
+			return t, f.Name, nil
+		}
+
+		if first == nil {
+			first = err
+		}
+	}
+
+	if first != nil {
+		return Time{}, "", first
+	}
+
+	return Time{}, "", newParseError(s, 0, ComponentNone, ErrUnknownFormat,
+		"no registered format recognizes the input")
+}
+
+// ParseAny parses s in any format registered in DefaultRegistry and
+// returns the name of the format detected, such as "dtg" or "rfc3339".
+// It is equivalent to Parser{}.ParseAny(s).
+func ParseAny(s string) (Time, string, error) {
+	return Parser{}.ParseAny(s)
+}
+
+// ParseAny is like the package-level ParseAny but uses the parser's
+// defaults, such as its reference time and default zone.
+func (p Parser) ParseAny(s string) (Time, string, error) {
+	return DefaultRegistry.Parse(p, s)
+}
+
+// detectDTG reports whether s starts with a digit, holds only the
+// characters of a date-time-group, and is not a run of digits of a
+// length that a date-time-group cannot have.
+func detectDTG(s string) bool {
+	if s == "" || !isDigit(s[0]) {
+		return false
+	}
+
+	digits := 0
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case isDigit(c):
+			digits++
+		case isLetter(c), c == ' ', c == '*', c == '\'':
+		case strings.HasPrefix(s[i:], suffixThreeQuarterHour):
+			i += len(suffixThreeQuarterHour) - 1
+		default:
+			return false
+		}
+	}
+
+	return digits < len(s) || digits == 6 || digits == 8 || digits == 12
+}
+
+// detectRFC3339 reports whether s starts with a "YYYY-MM-DDT" date.
+func detectRFC3339(s string) bool {
+	return len(s) > 10 && s[4] == '-' && s[7] == '-' && (s[10] == 'T' || s[10] == 't')
+}
+
+// parseRFC3339 parses an RFC 3339 time.
+func parseRFC3339(p Parser, s string) (Time, error) {
+	return p.parseTimeLayouts(s, time.RFC3339Nano)
+}
+
+// detectISO8601Basic reports whether s starts with a "YYYYMMDDT" date.
+func detectISO8601Basic(s string) bool {
+	if len(s) < 13 || s[8] != 'T' {
+		return false
+	}
+
+	_, ok := strictDigits(s, 0, 8)
+
+	return ok
+}
+
+// parseISO8601Basic parses an ISO 8601 basic time with or without seconds.
+// A time without an offset is in the default zone.
+func parseISO8601Basic(p Parser, s string) (Time, error) {
+	return p.parseTimeLayouts(s, "20060102T150405Z0700", "20060102T1504Z0700",
+		"20060102T150405", "20060102T1504")
+}
+
+// detectDayMonthYear reports whether s starts with "DD MMM ".
+func detectDayMonthYear(s string) bool {
+	return len(s) > 7 && isDigit(s[0]) && isDigit(s[1]) && s[2] == ' ' &&
+		layoutWordLen(s, 3) == 3 && s[6] == ' '
+}
+
+// parseDayMonthYear parses "DD MMM YYYY HHMM" with an optional zone.
+func parseDayMonthYear(p Parser, s string) (Time, error) {
+	layout := "DD MMM YYYY hhmm"
+	if len(s) > len(layout) {
+		layout += "Z"
+	}
+
+	return p.ParseLayout(layout, s)
+}
+
+// detectJulian reports whether s is four, five or seven digits.
+func detectJulian(s string) bool {
+	if len(s) != 4 && len(s) != 5 && len(s) != 7 {
+		return false
+	}
+
+	_, ok := strictDigits(s, 0, len(s))
+
+	return ok
+}
+
+// detectUnix reports whether s is nine to eleven digits with an optional sign.
+func detectUnix(s string) bool {
+	s = strings.TrimPrefix(s, "-")
+	if len(s) < 9 || len(s) > 11 {
+		return false
+	}
+
+	_, ok := strictDigits(s, 0, len(s))
+
+	return ok
+}
+
+// parseUnix parses a count of Unix seconds into the default zone.
+func parseUnix(p Parser, s string) (Time, error) {
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return Time{}, newParseError(s, 0, ComponentNone, ErrInvalidDateTimeGroup, "%v", err)
+	}
+
+	t := time.Unix(n, 0).In(p.location(p.defaultZone()))
+
+	return p.checkYear(s, t)
+}
+
+// parseTimeLayouts parses s with the first of the time.Time layouts that
+// accepts it. A time without an offset is in the default zone, and a time
+// whose offset matches a military time zone is placed in that zone.
+func (p Parser) parseTimeLayouts(s string, layouts ...string) (Time, error) {
+	var err error
+	for _, layout := range layouts {
+		var t time.Time
+		t, err = time.ParseInLocation(layout, s, p.location(p.defaultZone()))
+		if err != nil {
+			continue
+		}
+
+		if _, offset := t.Zone(); t.Location() != p.julietLocation() {
+			if tz, ok := ZoneByOffset(offset); ok {
+				t = t.In(tz.Location())
+			}
+		}
+
+		return p.checkYear(s, t)
+	}
+
+	return Time{}, newParseError(s, 0, ComponentNone, ErrInvalidDateTimeGroup, "%v", err)
+}
+
+// checkYear returns t if its year is within the parser's bounds.
+func (p Parser) checkYear(s string, t time.Time) (Time, error) {
+	if !p.yearInRange(t.Year()) {
+		lo, hi := p.yearBounds()
+		return Time{}, newParseError(s, 0, ComponentYear, ErrYearOutOfRange,
+			"year %d out of range %d to %d", t.Year(), lo, hi)
+	}
+
+	return NewTime(t), nil
+}
@@ -0,0 +1,230 @@
+package mildtg
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestParser_ParseAny(t *testing.T) {
+	t.Parallel()
+
+	p := Parser{Reference: time.Date(2024, 3, 10, 15, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name   string
+		input  string
+		want   time.Time
+		zone   string
+		format string
+		error  error
+	}{
+		{
+			name:   "dtg",
+			input:  "011200Z JAN 24",
+			want:   time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+			zone:   "Z",
+			format: "dtg",
+		},
+		{
+			name:   "rfc3339 zulu",
+			input:  "2024-01-01T12:00:00Z",
+			want:   time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+			zone:   "Z",
+			format: "rfc3339",
+		},
+		{
+			name:   "rfc3339 offset",
+			input:  "2024-01-01T12:00:00.5+01:00",
+			want:   time.Date(2024, 1, 1, 11, 0, 0, 5e8, time.UTC),
+			zone:   "A",
+			format: "rfc3339",
+		},
+		{
+			name:   "iso8601 basic",
+			input:  "20240101T120000Z",
+			want:   time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+			zone:   "Z",
+			format: "iso8601-basic",
+		},
+		{
+			name:   "iso8601 basic with extended zone",
+			input:  "20240101T1200+0530",
+			want:   time.Date(2024, 1, 1, 6, 30, 0, 0, time.UTC),
+			zone:   "E*",
+			format: "iso8601-basic",
+		},
+		{
+			name:   "iso8601 basic without offset",
+			input:  "20240101T1200",
+			want:   time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+			zone:   "Z",
+			format: "iso8601-basic",
+		},
+		{
+			name:   "day month year",
+			input:  "01 JAN 2024 1200",
+			want:   time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+			zone:   "Z",
+			format: "day-month-year",
+		},
+		{
+			name:   "day month year with zone",
+			input:  "01 JAN 2024 1200R",
+			want:   time.Date(2024, 1, 1, 17, 0, 0, 0, time.UTC),
+			zone:   "R",
+			format: "day-month-year",
+		},
+		{
+			name:   "julian",
+			input:  "4032",
+			want:   time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			zone:   "Z",
+			format: "julian",
+		},
+		{
+			name:   "julian leap day in an earlier year",
+			input:  "0060",
+			want:   time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC),
+			zone:   "Z",
+			format: "julian",
+		},
+		{
+			name:   "unix",
+			input:  "1704110400",
+			want:   time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+			zone:   "Z",
+			format: "unix",
+		},
+		{
+			name:   "ten digits are unix",
+			input:  "0112002024",
+			want:   time.Unix(112002024, 0),
+			zone:   "Z",
+			format: "unix",
+		},
+		{
+			name:  "unknown",
+			input: "next tuesday",
+			error: ErrUnknownFormat,
+		},
+		{
+			name:  "detected but invalid",
+			input: "312500Z JAN 24",
+			error: ErrInvalidDateTimeGroup,
+		},
+		{
+			name:  "julian day out of range",
+			input: "3366",
+			error: ErrInvalidDay,
+		},
+		{
+			name:  "rfc3339 year out of range",
+			input: "1900-01-01T00:00:00Z",
+			error: ErrYearOutOfRange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, format, err := p.ParseAny(tt.input)
+			if !errors.Is(err, tt.error) {
+				t.Fatalf("got %v, want %v", err, tt.error)
+			}
+
+			if err != nil {
+				if tt.error != ErrUnknownFormat && errors.Is(err, ErrUnknownFormat) {
+					t.Errorf("got %v, want a parse error", err)
+				}
+
+				return
+			}
+
+			if format != tt.format {
+				t.Errorf("got format %v, want %v", format, tt.format)
+			}
+
+			if !got.Equal(tt.want) {
+				t.Errorf("got %v, want %v", got.Time, tt.want)
+			}
+
+			if zone, _ := got.Zone(); zone != tt.zone {
+				t.Errorf("got zone %v, want %v", zone, tt.zone)
+			}
+		})
+	}
+}
+
+func TestParseAny(t *testing.T) {
+	t.Parallel()
+
+	_, format, err := ParseAny("011200Z JAN 2024")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if format != DTGInput.Name {
+		t.Errorf("got %v, want %v", format, DTGInput.Name)
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	t.Parallel()
+
+	r := NewRegistry(DTGInput, UnixInput)
+
+	if got, want := r.Names(), []string{"dtg", "unix"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if !r.Disable("unix") {
+		t.Errorf("got false, want unix to be registered")
+	}
+
+	if _, _, err := r.Parse(Parser{}, "1704110400"); !errors.Is(err, ErrUnknownFormat) {
+		t.Errorf("got %v, want %v", err, ErrUnknownFormat)
+	}
+
+	if !r.Enable("unix") {
+		t.Errorf("got false, want unix to be registered")
+	}
+
+	if r.Enable("rfc3339") {
+		t.Errorf("got true, want rfc3339 to be unregistered")
+	}
+
+	// Milliseconds since the epoch, thirteen digits.
+	r.Register(InputFormat{
+		Name:   "unix-ms",
+		Detect: func(s string) bool { return len(s) == 13 },
+		Parse: func(p Parser, s string) (Time, error) {
+			ms, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return Time{}, err
+			}
+
+			return NewTime(time.Unix(0, ms*int64(time.Millisecond)).In(ZULU.Location())), nil
+		},
+	})
+
+	got, format, err := r.Parse(Parser{}, "1704110400000")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if format != "unix-ms" || got.Format(MILDTGFULLYEAR) != "011200Z JAN 2024" {
+		t.Errorf("got %v %v, want unix-ms 011200Z JAN 2024", format, got)
+	}
+
+	// Registering a format again replaces it in place.
+	r.Register(InputFormat{Name: "dtg", Detect: func(string) bool { return false }, Parse: Parser.Parse})
+	if got, want := r.Names(), []string{"dtg", "unix", "unix-ms"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if _, _, err := r.Parse(Parser{}, "011200Z JAN 2024"); !errors.Is(err, ErrUnknownFormat) {
+		t.Errorf("got %v, want %v", err, ErrUnknownFormat)
+	}
+}
@@ -0,0 +1,58 @@
+package mildtg
+
+import "time"
+
+// The methods below shadow the time.Time methods of the same name so
+// that calls can be chained without wrapping each result in NewTime.
+
+// Add returns the time t+d.
+func (t Time) Add(d time.Duration) Time {
+	return Time{t.Time.Add(d)}
+}
+
+// AddDate returns the time corresponding to adding the given number of
+// years, months and days to t, as for time.Time.AddDate.
+func (t Time) AddDate(years, months, days int) Time {
+	return Time{t.Time.AddDate(years, months, days)}
+}
+
+// In returns a copy of t representing the same instant in loc.
+// It panics if loc is nil.
+func (t Time) In(loc *time.Location) Time {
+	return Time{t.Time.In(loc)}
+}
+
+// Local returns t in the local time zone. It is written with the letter
+// for its UTC offset, or as Juliet by a Formatter whose Juliet location
+// is time.Local.
+func (t Time) Local() Time {
+	return Time{t.Time.Local()}
+}
+
+// UTC returns t in UTC, which is written as Zulu.
+func (t Time) UTC() Time {
+	return Time{t.Time.UTC()}
+}
+
+// Truncate returns the result of rounding t down to a multiple of d
+// since the zero time, as for time.Time.Truncate.
+func (t Time) Truncate(d time.Duration) Time {
+	return Time{t.Time.Truncate(d)}
+}
+
+// Round returns the result of rounding t to the nearest multiple of d
+// since the zero time, as for time.Time.Round.
+func (t Time) Round(d time.Duration) Time {
+	return Time{t.Time.Round(d)}
+}
+
+// InZone returns a copy of t representing the same instant in the
+// military time zone tz. Juliet is time.Local.
+func (t Time) InZone(tz TimeZone) Time {
+	return Time{t.Time.In(tz.Location())}
+}
+
+// ToZulu returns a copy of t representing the same instant in Zulu.
+func (t Time) ToZulu() Time {
+	return t.InZone(ZULU)
+}
@@ -0,0 +1,72 @@
+package mildtg
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTime_Arithmetic(t *testing.T) {
+	t.Parallel()
+
+	base := NewTime(time.Date(2024, 1, 31, 12, 34, 56, 0, ROMEO.Location()))
+
+	tests := []struct {
+		name string
+		got  Time
+		want string
+	}{
+		{name: "add", got: base.Add(90 * time.Minute), want: "31140456R JAN 2024"},
+		{name: "add date", got: base.AddDate(0, 1, 0), want: "02123456R MAR 2024"},
+		{name: "in", got: base.In(time.FixedZone("+0100", 3600)), want: "31183456A JAN 2024"},
+		{name: "utc", got: base.UTC(), want: "31173456Z JAN 2024"},
+		{name: "truncate", got: base.Truncate(time.Hour), want: "311200R JAN 2024"},
+		{name: "round", got: base.Round(time.Hour), want: "311300R JAN 2024"},
+		{name: "in zone", got: base.InZone(KILO), want: "01033456K FEB 2024"},
+		{name: "in extended zone", got: base.InZone(mustZone(t, "E*")), want: "31230456E* JAN 2024"},
+		{name: "to zulu", got: base.ToZulu(), want: "31173456Z JAN 2024"},
+		{name: "chained", got: base.ToZulu().Add(time.Hour).Truncate(time.Hour), want: "311800Z JAN 2024"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.got.Format(MILDTGFULLYEAR); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTime_ToZuluLocation(t *testing.T) {
+	t.Parallel()
+
+	got := NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, ROMEO.Location())).ToZulu()
+	if got.Location() != ZULU.Location() {
+		t.Errorf("got %v, want %v", got.Location(), ZULU.Location())
+	}
+}
+
+func TestTime_Local(t *testing.T) {
+	t.Parallel()
+
+	in := NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, ROMEO.Location()))
+	got := in.Local()
+
+	if got.Location() != time.Local || !got.Equal(in.Time) {
+		t.Errorf("got %v, want %v in %v", got, in, time.Local)
+	}
+
+	if got := in.InZone(JULIET); got.Location() != time.Local {
+		t.Errorf("got %v, want %v", got.Location(), time.Local)
+	}
+}
+
+func mustZone(t *testing.T, designator string) TimeZone {
+	t.Helper()
+
+	tz, ok := ZoneByDesignator(designator)
+	if !ok {
+		t.Fatalf("unknown zone %q", designator)
+	}
+
+	return tz
+}
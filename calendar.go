@@ -0,0 +1,317 @@
+package mildtg
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// CalendarFormat selects how a JulianCalendar is written.
+type CalendarFormat int
+
+const (
+	// CalendarText writes a fixed-width plain text chart.
+	CalendarText CalendarFormat = iota
+
+	// CalendarMarkdown writes a Markdown table.
+	CalendarMarkdown
+
+	// CalendarCSV writes comma-separated values with a header record.
+	CalendarCSV
+
+	// CalendarHTML writes an HTML table.
+	CalendarHTML
+)
+
+// String returns the lower-case name of the format, such as "markdown".
+func (f CalendarFormat) String() string {
+	switch f {
+	case CalendarText:
+		return "text"
+	case CalendarMarkdown:
+		return "markdown"
+	case CalendarCSV:
+		return "csv"
+	case CalendarHTML:
+		return "html"
+	default:
+		return "CalendarFormat(" + strconv.Itoa(int(f)) + ")"
+	}
+}
+
+// JulianCalendar writes the Julian calendar chart posted by supply and
+// maintenance sections, which lists the ordinal day of the year for each
+// day of each month. Rows are the days 01 to 31 and columns are the
+// months JAN to DEC, so the cell for January 15 holds "015". Days that do
+// not exist, such as February 30, are left blank, and February 29 is
+// included in leap years.
+//
+// The zero value writes plain text without highlighting.
+type JulianCalendar struct {
+	// Format selects the output format.
+	Format CalendarFormat
+
+	// Highlight marks the day of the year it falls on, in its own time
+	// zone, if that is the year being written. The zero Time marks
+	// nothing. Text and CSV cells are marked "[015]", Markdown cells are
+	// bold and HTML cells have the class "highlight".
+	Highlight Time
+
+	// MinYear and MaxYear bound the year written, inclusive, as for
+	// Formatter. If zero, MinYear is 1941 and MaxYear is 9999.
+	MinYear int
+	MaxYear int
+}
+
+// WriteJulianCalendar writes the Julian calendar for year to w in the
+// given format.
+//
+// WriteJulianCalendar is equivalent to
+// JulianCalendar{Format: format}.Write(w, year).
+func WriteJulianCalendar(w io.Writer, year int, format CalendarFormat) error {
+	return JulianCalendar{Format: format}.Write(w, year)
+}
+
+// Write writes the Julian calendar for year to w. A year outside the
+// calendar's bounds is rejected with ErrYearOutOfRange.
+func (c JulianCalendar) Write(w io.Writer, year int) error {
+	lo, hi := yearBounds(c.MinYear, c.MaxYear)
+	if year < lo || year > hi {
+		return fmt.Errorf("%w: %d is not between %d and %d", ErrYearOutOfRange, year, lo, hi)
+	}
+
+	grid := newJulianGrid(year, c.highlight(year))
+
+	var b []byte
+	switch c.Format {
+	case CalendarText:
+		b = grid.appendText(b)
+	case CalendarMarkdown:
+		b = grid.appendMarkdown(b)
+	case CalendarCSV:
+		return grid.writeCSV(w)
+	case CalendarHTML:
+		b = grid.appendHTML(b)
+	default:
+		return fmt.Errorf("mildtg: unknown calendar format %v", c.Format)
+	}
+
+	_, err := w.Write(b)
+	return err
+}
+
+// highlight returns the day of the year to mark in year, or 0 for none.
+func (c JulianCalendar) highlight(year int) int {
+	if c.Highlight.IsZero() || c.Highlight.Year() != year {
+		return 0
+	}
+
+	return c.Highlight.YearDay()
+}
+
+// julianGrid holds the ordinal day of each day of each month of a year,
+// with 0 for days that do not exist.
+type julianGrid struct {
+	year      int
+	highlight int
+	days      [31][12]int
+}
+
+// newJulianGrid returns the grid for year, marking day highlight.
+func newJulianGrid(year, highlight int) *julianGrid {
+	g := &julianGrid{year: year, highlight: highlight}
+
+	yday := 0
+	for m := time.January; m <= time.December; m++ {
+		for d := 1; d <= daysInMonth(m, year); d++ {
+			yday++
+			g.days[d-1][m-1] = yday
+		}
+	}
+
+	return g
+}
+
+// title returns the caption of the calendar, such as
+// "JULIAN CALENDAR 2024 (LEAP YEAR)".
+func (g *julianGrid) title() string {
+	s := "JULIAN CALENDAR " + strconv.Itoa(g.year)
+	if daysInYear(g.year) == 366 {
+		s += " (LEAP YEAR)"
+	}
+
+	return s
+}
+
+// appendCell appends the three-digit ordinal day yday to b, or nothing
+// if it is 0.
+func appendCell(b []byte, yday int) []byte {
+	if yday == 0 {
+		return b
+	}
+
+	return appendInt(b, yday, 3)
+}
+
+// appendText appends the grid to b as a fixed-width plain text chart.
+func (g *julianGrid) appendText(b []byte) []byte {
+	b = append(b, g.title()...)
+	b = append(b, "\n\n DAY "...)
+	for m := time.January; m <= time.December; m++ {
+		b = append(b, "  "...)
+		b = append(b, monthNames[m][:3]...)
+		b = append(b, ' ')
+	}
+	b = trimSpaces(b)
+	b = append(b, '\n')
+
+	for d, row := range g.days {
+		b = append(b, "  "...)
+		b = appendInt(b, d+1, 2)
+		b = append(b, ' ')
+
+		for _, yday := range row {
+			b = append(b, ' ')
+			switch {
+			case yday == 0:
+				b = append(b, "     "...)
+			case yday == g.highlight:
+				b = append(b, '[')
+				b = appendCell(b, yday)
+				b = append(b, ']')
+			default:
+				b = append(b, ' ')
+				b = appendCell(b, yday)
+				b = append(b, ' ')
+			}
+		}
+
+		b = trimSpaces(b)
+		b = append(b, '\n')
+	}
+
+	return b
+}
+
+// appendMarkdown appends the grid to b as a Markdown table.
+func (g *julianGrid) appendMarkdown(b []byte) []byte {
+	b = append(b, "## "...)
+	b = append(b, g.title()...)
+	b = append(b, "\n\n| DAY |"...)
+	for m := time.January; m <= time.December; m++ {
+		b = append(b, ' ')
+		b = append(b, monthNames[m][:3]...)
+		b = append(b, " |"...)
+	}
+
+	b = append(b, "\n| --: |"...)
+	for m := time.January; m <= time.December; m++ {
+		b = append(b, " --: |"...)
+	}
+	b = append(b, '\n')
+
+	for d, row := range g.days {
+		b = append(b, "| "...)
+		b = appendInt(b, d+1, 2)
+		b = append(b, " |"...)
+
+		for _, yday := range row {
+			b = append(b, ' ')
+			if yday != 0 && yday == g.highlight {
+				b = append(b, "**"...)
+				b = appendCell(b, yday)
+				b = append(b, "**"...)
+			} else {
+				b = appendCell(b, yday)
+			}
+			b = append(b, " |"...)
+		}
+
+		b = append(b, '\n')
+	}
+
+	return b
+}
+
+// writeCSV writes the grid to w as CSV records with a header record.
+func (g *julianGrid) writeCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	record := make([]string, 13)
+	record[0] = "DAY"
+	for m := time.January; m <= time.December; m++ {
+		record[m] = monthNames[m][:3]
+	}
+
+	if err := cw.Write(record); err != nil {
+		return err
+	}
+
+	var b []byte
+	for d, row := range g.days {
+		record[0] = string(appendInt(b[:0], d+1, 2))
+
+		for m, yday := range row {
+			b = b[:0]
+			if yday != 0 && yday == g.highlight {
+				b = append(b, '[')
+				b = appendCell(b, yday)
+				b = append(b, ']')
+			} else {
+				b = appendCell(b, yday)
+			}
+			record[m+1] = string(b)
+		}
+
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// appendHTML appends the grid to b as an HTML table.
+func (g *julianGrid) appendHTML(b []byte) []byte {
+	b = append(b, "<table class=\"julian-calendar\">\n<caption>"...)
+	b = append(b, g.title()...)
+	b = append(b, "</caption>\n<thead>\n<tr><th>DAY</th>"...)
+	for m := time.January; m <= time.December; m++ {
+		b = append(b, "<th>"...)
+		b = append(b, monthNames[m][:3]...)
+		b = append(b, "</th>"...)
+	}
+	b = append(b, "</tr>\n</thead>\n<tbody>\n"...)
+
+	for d, row := range g.days {
+		b = append(b, "<tr><th>"...)
+		b = appendInt(b, d+1, 2)
+		b = append(b, "</th>"...)
+
+		for _, yday := range row {
+			if yday != 0 && yday == g.highlight {
+				b = append(b, "<td class=\"highlight\">"...)
+			} else {
+				b = append(b, "<td>"...)
+			}
+			b = appendCell(b, yday)
+			b = append(b, "</td>"...)
+		}
+
+		b = append(b, "</tr>\n"...)
+	}
+
+	return append(b, "</tbody>\n</table>\n"...)
+}
+
+// trimSpaces removes trailing spaces from b.
+func trimSpaces(b []byte) []byte {
+	for len(b) > 0 && b[len(b)-1] == ' ' {
+		b = b[:len(b)-1]
+	}
+
+	return b
+}
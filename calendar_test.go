@@ -0,0 +1,182 @@
+package mildtg
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestJulianCalendar_Write(t *testing.T) {
+	t.Parallel()
+
+	leapDay := NewTime(time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name     string
+		calendar JulianCalendar
+		year     int
+		lines    map[int]string
+	}{
+		{
+			name:     "text",
+			calendar: JulianCalendar{},
+			year:     2023,
+			lines: map[int]string{
+				0:  "JULIAN CALENDAR 2023",
+				2:  " DAY   JAN   FEB   MAR   APR   MAY   JUN   JUL   AUG   SEP   OCT   NOV   DEC",
+				3:  "  01   001   032   060   091   121   152   182   213   244   274   305   335",
+				31: "  29   029         088   119   149   180   210   241   272   302   333   363",
+				33: "  31   031         090         151         212   243         304         365",
+			},
+		},
+		{
+			name:     "text leap year highlighted",
+			calendar: JulianCalendar{Highlight: leapDay},
+			year:     2024,
+			lines: map[int]string{
+				0:  "JULIAN CALENDAR 2024 (LEAP YEAR)",
+				31: "  29   029  [060]  089   120   150   181   211   242   273   303   334   364",
+				33: "  31   031         091         152         213   244         305         366",
+			},
+		},
+		{
+			name:     "highlight in another year",
+			calendar: JulianCalendar{Highlight: leapDay},
+			year:     2025,
+			lines: map[int]string{
+				31: "  29   029         088   119   149   180   210   241   272   302   333   363",
+			},
+		},
+		{
+			name:     "markdown",
+			calendar: JulianCalendar{Format: CalendarMarkdown, Highlight: leapDay},
+			year:     2024,
+			lines: map[int]string{
+				0:  "## JULIAN CALENDAR 2024 (LEAP YEAR)",
+				2:  "| DAY | JAN | FEB | MAR | APR | MAY | JUN | JUL | AUG | SEP | OCT | NOV | DEC |",
+				3:  "| --: | --: | --: | --: | --: | --: | --: | --: | --: | --: | --: | --: | --: |",
+				32: "| 29 | 029 | **060** | 089 | 120 | 150 | 181 | 211 | 242 | 273 | 303 | 334 | 364 |",
+				34: "| 31 | 031 |  | 091 |  | 152 |  | 213 | 244 |  | 305 |  | 366 |",
+			},
+		},
+		{
+			name:     "csv",
+			calendar: JulianCalendar{Format: CalendarCSV, Highlight: leapDay},
+			year:     2024,
+			lines: map[int]string{
+				0:  "DAY,JAN,FEB,MAR,APR,MAY,JUN,JUL,AUG,SEP,OCT,NOV,DEC",
+				29: "29,029,[060],089,120,150,181,211,242,273,303,334,364",
+				31: "31,031,,091,,152,,213,244,,305,,366",
+			},
+		},
+		{
+			name:     "html",
+			calendar: JulianCalendar{Format: CalendarHTML, Highlight: leapDay},
+			year:     2024,
+			lines: map[int]string{
+				0: `<table class="julian-calendar">`,
+				1: "<caption>JULIAN CALENDAR 2024 (LEAP YEAR)</caption>",
+				3: "<tr><th>DAY</th><th>JAN</th><th>FEB</th><th>MAR</th><th>APR</th><th>MAY</th>" +
+					"<th>JUN</th><th>JUL</th><th>AUG</th><th>SEP</th><th>OCT</th><th>NOV</th><th>DEC</th></tr>",
+				34: "<tr><th>29</th><td>029</td><td class=\"highlight\">060</td><td>089</td><td>120</td>" +
+					"<td>150</td><td>181</td><td>211</td><td>242</td><td>273</td><td>303</td><td>334</td><td>364</td></tr>",
+				36: "<tr><th>31</th><td>031</td><td></td><td>091</td><td></td><td>152</td><td></td>" +
+					"<td>213</td><td>244</td><td></td><td>305</td><td></td><td>366</td></tr>",
+				38: "</table>",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tt.calendar.Write(&buf, tt.year); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			lines := strings.Split(buf.String(), "\n")
+			for n, want := range tt.lines {
+				if n >= len(lines) {
+					t.Fatalf("got %d lines, want at least %d", len(lines), n+1)
+				}
+
+				if lines[n] != want {
+					t.Errorf("line %d: got %q, want %q", n, lines[n], want)
+				}
+			}
+		})
+	}
+}
+
+func TestJulianCalendar_WriteErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		calendar JulianCalendar
+		year     int
+		error    error
+	}{
+		{name: "before min year", calendar: JulianCalendar{}, year: 1940, error: ErrYearOutOfRange},
+		{name: "after max year", calendar: JulianCalendar{MaxYear: 2050}, year: 2051, error: ErrYearOutOfRange},
+		{name: "unknown format", calendar: JulianCalendar{Format: CalendarHTML + 1}, year: 2024},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.calendar.Write(&bytes.Buffer{}, tt.year)
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			if tt.error != nil && !errors.Is(err, tt.error) {
+				t.Errorf("got %v, want %v", err, tt.error)
+			}
+		})
+	}
+}
+
+func TestWriteJulianCalendar(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	if err := WriteJulianCalendar(&buf, 1900, CalendarCSV); !errors.Is(err, ErrYearOutOfRange) {
+		t.Errorf("got %v, want %v", err, ErrYearOutOfRange)
+	}
+
+	if err := WriteJulianCalendar(&buf, 2100, CalendarCSV); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// 2100 is not a leap year.
+	if got, want := strings.Count(buf.String(), ","), 13*32-32; got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if !strings.Contains(buf.String(), "\n29,029,,088,") {
+		t.Errorf("got %q, want no February 29", buf.String())
+	}
+}
+
+func TestCalendarFormat_String(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input CalendarFormat
+		want  string
+	}{
+		{input: CalendarText, want: "text"},
+		{input: CalendarMarkdown, want: "markdown"},
+		{input: CalendarCSV, want: "csv"},
+		{input: CalendarHTML, want: "html"},
+		{input: CalendarFormat(9), want: "CalendarFormat(9)"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.input.String(); got != tt.want {
+			t.Errorf("got %v, want %v", got, tt.want)
+		}
+	}
+}
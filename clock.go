@@ -0,0 +1,56 @@
+package mildtg
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Clock provides the current time. A Parser uses it to infer a missing
+// month or year, and Now uses it to stamp the current date-time-group.
+// The mildtgtest package provides a Clock that tests can set and advance.
+type Clock interface {
+	Now() time.Time
+}
+
+// systemClock is the Clock that reads time.Now.
+type systemClock struct{}
+
+// Now returns time.Now().
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// clockValue holds the package Clock in an atomic.Value, which
+// requires every stored value to have the same concrete type.
+type clockValue struct {
+	Clock
+}
+
+var packageClock atomic.Value
+
+func init() {
+	packageClock.Store(clockValue{systemClock{}})
+}
+
+// SetClock sets the Clock used by ParseDTG, Now, and any Parser without
+// its own Clock or Reference. A nil Clock restores the system clock.
+// SetClock is safe for concurrent use, but tests that change the
+// package clock should not run in parallel with tests that read it.
+func SetClock(c Clock) {
+	if c == nil {
+		c = systemClock{}
+	}
+
+	packageClock.Store(clockValue{c})
+}
+
+// currentClock returns the package Clock.
+func currentClock() Clock {
+	return packageClock.Load().(clockValue).Clock
+}
+
+// Now returns the current time of the package Clock in the time zone tz.
+// Juliet is time.Local.
+func Now(tz TimeZone) Time {
+	return NewTime(currentClock().Now()).InZone(tz)
+}
@@ -0,0 +1,46 @@
+package mildtg
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Type3Solutions/mildtg/mildtgtest"
+)
+
+// TestSetClock changes the package clock, so it must not run in parallel.
+func TestSetClock(t *testing.T) {
+	clock := mildtgtest.NewClock(time.Date(2024, 12, 31, 23, 30, 0, 0, time.UTC))
+	SetClock(clock)
+	defer SetClock(nil)
+
+	got, err := ParseDTG("010100Z")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := time.Date(2025, 1, 1, 1, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("got %v, want %v", got.Time, want)
+	}
+
+	if got := Now(ROMEO).Format(MILDTGFULLYEAR); got != "311830R DEC 2024" {
+		t.Errorf("got %v, want %v", got, "311830R DEC 2024")
+	}
+
+	// A parser's own clock takes precedence over the package clock.
+	p := Parser{Clock: mildtgtest.NewClock(time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC))}
+	got, err = p.Parse("010100Z")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want = time.Date(2024, 6, 1, 1, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("got %v, want %v", got.Time, want)
+	}
+
+	SetClock(nil)
+	if got := Now(ZULU); time.Since(got.Time) > time.Minute {
+		t.Errorf("got %v, want the current time", got)
+	}
+}
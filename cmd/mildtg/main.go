@@ -0,0 +1,128 @@
+// Command mildtg works with military date-time-groups.
+//
+// Usage:
+//
+//	mildtg julian [-format text|markdown|csv|html] [-highlight date] [year]
+//
+// The julian subcommand writes the Julian calendar chart for a year,
+// listing the ordinal day of the year for each day of each month. The
+// year defaults to the year of the highlighted date, or else the current
+// year in Zulu time. The highlighted date may be "today" or any input
+// accepted by mildtg.ParseAny, such as "4060", "2024-02-29T12:00:00Z" or
+// "291200Z FEB 24".
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/Type3Solutions/mildtg"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run executes the command line args, returning the exit status.
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr)
+		return 2
+	}
+
+	switch args[0] {
+	case "julian":
+		return runJulian(args[1:], stdout, stderr)
+	case "help", "-h", "-help", "--help":
+		usage(stdout)
+		return 0
+	default:
+		fmt.Fprintf(stderr, "mildtg: unknown command %q\n", args[0])
+		usage(stderr)
+		return 2
+	}
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage: mildtg julian [-format text|markdown|csv|html] [-highlight date] [year]")
+}
+
+// runJulian writes a Julian calendar chart.
+func runJulian(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("julian", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	format := fs.String("format", "text", "output `format`: text, markdown, csv or html")
+	highlight := fs.String("highlight", "", "`date` to highlight, or \"today\"")
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+
+	if fs.NArg() > 1 {
+		fmt.Fprintln(stderr, "mildtg julian: too many arguments")
+		return 2
+	}
+
+	var c mildtg.JulianCalendar
+
+	var ok bool
+	if c.Format, ok = calendarFormat(*format); !ok {
+		fmt.Fprintf(stderr, "mildtg julian: unknown format %q\n", *format)
+		return 2
+	}
+
+	switch *highlight {
+	case "":
+	case "today":
+		c.Highlight = mildtg.Now(mildtg.ZULU)
+	default:
+		t, _, err := mildtg.ParseAny(*highlight)
+		if err != nil {
+			fmt.Fprintf(stderr, "mildtg julian: highlight: %v\n", err)
+			return 1
+		}
+
+		c.Highlight = t
+	}
+
+	year := mildtg.Now(mildtg.ZULU).Year()
+	if !c.Highlight.IsZero() {
+		year = c.Highlight.Year()
+	}
+
+	if fs.NArg() == 1 {
+		y, err := strconv.Atoi(fs.Arg(0))
+		if err != nil {
+			fmt.Fprintf(stderr, "mildtg julian: invalid year %q\n", fs.Arg(0))
+			return 2
+		}
+
+		year = y
+	}
+
+	if err := c.Write(stdout, year); err != nil {
+		fmt.Fprintf(stderr, "mildtg julian: %v\n", err)
+		return 1
+	}
+
+	return 0
+}
+
+// calendarFormat returns the calendar format with the given name.
+func calendarFormat(name string) (mildtg.CalendarFormat, bool) {
+	for f := mildtg.CalendarText; f <= mildtg.CalendarHTML; f++ {
+		if f.String() == name {
+			return f, true
+		}
+	}
+
+	return 0, false
+}
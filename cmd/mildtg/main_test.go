@@ -0,0 +1,121 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Type3Solutions/mildtg"
+	"github.com/Type3Solutions/mildtg/mildtgtest"
+)
+
+func TestRun(t *testing.T) {
+	mildtg.SetClock(mildtgtest.NewClock(time.Date(2023, 7, 4, 12, 0, 0, 0, time.UTC)))
+	t.Cleanup(func() { mildtg.SetClock(nil) })
+
+	tests := []struct {
+		name   string
+		args   []string
+		status int
+		stdout []string
+		stderr string
+	}{
+		{
+			name:   "current year",
+			args:   []string{"julian"},
+			stdout: []string{"JULIAN CALENDAR 2023\n"},
+		},
+		{
+			name:   "year argument",
+			args:   []string{"julian", "2024"},
+			stdout: []string{"JULIAN CALENDAR 2024 (LEAP YEAR)\n"},
+		},
+		{
+			name:   "highlight today",
+			args:   []string{"julian", "-highlight", "today"},
+			stdout: []string{"  04   004   035   063   094   124   155  [185]"},
+		},
+		{
+			name:   "highlight sets the year",
+			args:   []string{"julian", "-format", "csv", "-highlight", "291200Z FEB 24"},
+			stdout: []string{"\n29,029,[060],089,"},
+		},
+		{
+			name:   "highlight julian date",
+			args:   []string{"julian", "-format", "markdown", "-highlight", "2024060"},
+			stdout: []string{"## JULIAN CALENDAR 2024 (LEAP YEAR)", "| **060** |"},
+		},
+		{
+			name:   "html",
+			args:   []string{"julian", "-format", "html", "2025"},
+			stdout: []string{"<caption>JULIAN CALENDAR 2025</caption>"},
+		},
+		{
+			name:   "help",
+			args:   []string{"help"},
+			stdout: []string{"usage: mildtg julian"},
+		},
+		{
+			name:   "no command",
+			status: 2,
+			stderr: "usage: mildtg julian",
+		},
+		{
+			name:   "unknown command",
+			args:   []string{"calendar"},
+			status: 2,
+			stderr: `unknown command "calendar"`,
+		},
+		{
+			name:   "unknown format",
+			args:   []string{"julian", "-format", "pdf"},
+			status: 2,
+			stderr: `unknown format "pdf"`,
+		},
+		{
+			name:   "invalid year",
+			args:   []string{"julian", "24x"},
+			status: 2,
+			stderr: `invalid year "24x"`,
+		},
+		{
+			name:   "too many arguments",
+			args:   []string{"julian", "2024", "2025"},
+			status: 2,
+			stderr: "too many arguments",
+		},
+		{
+			name:   "year out of range",
+			args:   []string{"julian", "1900"},
+			status: 1,
+			stderr: "year out of range",
+		},
+		{
+			name:   "invalid highlight",
+			args:   []string{"julian", "-highlight", "tomorrow"},
+			status: 1,
+			stderr: "highlight:",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+
+			if got := run(tt.args, &stdout, &stderr); got != tt.status {
+				t.Fatalf("got %v, want %v (stderr %q)", got, tt.status, stderr.String())
+			}
+
+			for _, want := range tt.stdout {
+				if !strings.Contains(stdout.String(), want) {
+					t.Errorf("got %q, want it to contain %q", stdout.String(), want)
+				}
+			}
+
+			if !strings.Contains(stderr.String(), tt.stderr) {
+				t.Errorf("got %q, want it to contain %q", stderr.String(), tt.stderr)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package mildtg
+
+import (
+	"math"
+	"time"
+)
+
+// duration returns the length of the unit of time.
+func (p Precision) duration() time.Duration {
+	if p == PrecisionSecond {
+		return time.Second
+	}
+
+	return time.Minute
+}
+
+// TruncateToDTG returns t rounded down to the precision of a
+// date-time-group, dropping the seconds for PrecisionMinute and the
+// fractional seconds for PrecisionSecond.
+func (t Time) TruncateToDTG(p Precision) Time {
+	return t.Truncate(p.duration())
+}
+
+// EqualDTG reports whether t and u are the same instant at precision p,
+// regardless of their time zones. For example, 011200Z JAN 24 and
+// 010700R JAN 24 are equal, as are 12:00:00 and 12:00:59 UTC at
+// PrecisionMinute.
+func (t Time) EqualDTG(u Time, p Precision) bool {
+	return t.TruncateToDTG(p).Equal(u.TruncateToDTG(p).Time)
+}
+
+// CompareDTG compares t and u at precision p. It returns -1 if t is
+// before u, 0 if they are equal as for EqualDTG, and +1 if t is after u.
+func (t Time) CompareDTG(u Time, p Precision) int {
+	t, u = t.TruncateToDTG(p), u.TruncateToDTG(p)
+
+	switch {
+	case t.Time.Before(u.Time):
+		return -1
+	case t.Time.After(u.Time):
+		return +1
+	default:
+		return 0
+	}
+}
+
+// BeforeDTG reports whether t is before u at precision p.
+func (t Time) BeforeDTG(u Time, p Precision) bool {
+	return t.CompareDTG(u, p) < 0
+}
+
+// AfterDTG reports whether t is after u at precision p.
+func (t Time) AfterDTG(u Time, p Precision) bool {
+	return t.CompareDTG(u, p) > 0
+}
+
+// keyFormatter writes keys for every representable year.
+var keyFormatter = Formatter{MinYear: math.MinInt, MaxYear: math.MaxInt}
+
+// Key returns a canonical date-time-group for t suitable as a map key:
+// the instant in Zulu, truncated to the second and written with seconds
+// and a four-digit year, such as "01170000Z JAN 2024". Two times have
+// the same key exactly when EqualDTG reports them equal at
+// PrecisionSecond. To group times by minute, use
+// t.TruncateToDTG(PrecisionMinute).Key(). The zero Time has an empty key.
+func (t Time) Key() string {
+	if t.IsZero() {
+		return ""
+	}
+
+	t = t.ToZulu().TruncateToDTG(PrecisionSecond)
+	b, _ := keyFormatter.appendLayout(make([]byte, 0, 32), t.Time, ZULU, "DDhhmmssZ MMM YYYY")
+
+	return string(b)
+}
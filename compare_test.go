@@ -0,0 +1,128 @@
+package mildtg
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTime_TruncateToDTG(t *testing.T) {
+	t.Parallel()
+
+	in := NewTime(time.Date(2024, 1, 1, 12, 0, 59, 999, ROMEO.Location()))
+
+	tests := []struct {
+		precision Precision
+		want      time.Time
+	}{
+		{precision: PrecisionMinute, want: time.Date(2024, 1, 1, 12, 0, 0, 0, ROMEO.Location())},
+		{precision: PrecisionSecond, want: time.Date(2024, 1, 1, 12, 0, 59, 0, ROMEO.Location())},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.precision.String(), func(t *testing.T) {
+			got := in.TruncateToDTG(tt.precision)
+			if !got.Equal(tt.want) || got.Location() != ROMEO.Location() {
+				t.Errorf("got %v, want %v", got.Time, tt.want)
+			}
+		})
+	}
+}
+
+func TestTime_CompareDTG(t *testing.T) {
+	t.Parallel()
+
+	zulu := NewTime(time.Date(2024, 1, 1, 12, 0, 30, 5, ZULU.Location()))
+
+	tests := []struct {
+		name      string
+		other     Time
+		precision Precision
+		want      int
+	}{
+		{
+			name:      "same instant in another zone",
+			other:     NewTime(time.Date(2024, 1, 1, 7, 0, 30, 5, ROMEO.Location())),
+			precision: PrecisionSecond,
+			want:      0,
+		},
+		{
+			name:      "parsed minute",
+			other:     NewTime(time.Date(2024, 1, 1, 7, 0, 0, 0, ROMEO.Location())),
+			precision: PrecisionMinute,
+			want:      0,
+		},
+		{
+			name:      "parsed minute at second precision",
+			other:     NewTime(time.Date(2024, 1, 1, 7, 0, 0, 0, ROMEO.Location())),
+			precision: PrecisionSecond,
+			want:      +1,
+		},
+		{
+			name:      "nanoseconds ignored",
+			other:     NewTime(time.Date(2024, 1, 1, 12, 0, 30, 999, ZULU.Location())),
+			precision: PrecisionSecond,
+			want:      0,
+		},
+		{
+			name:      "next minute",
+			other:     NewTime(time.Date(2024, 1, 1, 12, 1, 0, 0, ZULU.Location())),
+			precision: PrecisionMinute,
+			want:      -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := zulu.CompareDTG(tt.other, tt.precision); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+
+			if got := zulu.EqualDTG(tt.other, tt.precision); got != (tt.want == 0) {
+				t.Errorf("got %v, want %v", got, tt.want == 0)
+			}
+
+			if got := zulu.BeforeDTG(tt.other, tt.precision); got != (tt.want < 0) {
+				t.Errorf("got %v, want %v", got, tt.want < 0)
+			}
+
+			if got := zulu.AfterDTG(tt.other, tt.precision); got != (tt.want > 0) {
+				t.Errorf("got %v, want %v", got, tt.want > 0)
+			}
+		})
+	}
+}
+
+func TestTime_Key(t *testing.T) {
+	t.Parallel()
+
+	parsed, err := ParseDTG("01070030R JAN 2024")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	event := NewTime(time.Date(2024, 1, 1, 12, 0, 30, 123456789, time.UTC))
+
+	seen := map[string]bool{parsed.Key(): true}
+	if !seen[event.Key()] {
+		t.Errorf("got %v, want %v", event.Key(), parsed.Key())
+	}
+
+	tests := []struct {
+		name  string
+		input Time
+		want  string
+	}{
+		{name: "zulu", input: event, want: "01120030Z JAN 2024"},
+		{name: "minute", input: event.TruncateToDTG(PrecisionMinute), want: "01120000Z JAN 2024"},
+		{name: "outside year bounds", input: NewTime(time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)), want: "01000000Z JAN 1900"},
+		{name: "zero", input: Time{}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.Key(); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,307 @@
+package mildtg
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MILDATE is the layout for a date without a time of day, such as
+// "15 JAN 2024".
+const MILDATE = "DD MMM YYYY"
+
+const invalidDate = "INVALID DATE"
+
+// Date is a calendar date without a time of day or time zone, such as
+// the "15 JAN 24" of a date field. Use Date.At to combine it with a
+// TimeOfDay into a Time.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// DateOf returns the date of t in its own time zone.
+func DateOf(t Time) Date {
+	y, m, d := t.Time.Date()
+	return Date{Year: y, Month: m, Day: d}
+}
+
+// ParseDate parses a date written as a one- or two-digit day, a month
+// and a two- or four-digit year, such as "15 JAN 24", "15JAN2024" or
+// "5 January 2024". Spaces between the fields are optional and the month
+// may be abbreviated or written in full in any case. A two-digit year is
+// placed in a century as for ParseDTG, and years before 1941 or after
+// 9999 are rejected with ErrYearOutOfRange.
+//
+// ParseDate is equivalent to Parser{}.ParseDate(s).
+func ParseDate(s string) (Date, error) {
+	return Parser{}.ParseDate(s)
+}
+
+// ParseDate parses a date as described for the package-level ParseDate,
+// using the parser's CenturyWindow and year bounds. With Strict set, the
+// date must be written "DD MMM YY" or "DD MMM YYYY" with single spaces
+// and an upper-case month abbreviation.
+func (p Parser) ParseDate(s string) (Date, error) {
+	if err := p.validate(s); err != nil {
+		return Date{}, err
+	}
+
+	p = p.withReference()
+
+	var (
+		f   dtgFields
+		err error
+	)
+	if p.Strict {
+		f.day, _, err = layoutDigits(s, 0, 2, ComponentDay)
+		if err == nil {
+			err = p.parseStrictMonthYear(s, 2, &f)
+		}
+	} else {
+		f, err = p.parseDate(s)
+	}
+
+	if err != nil {
+		return Date{}, err
+	}
+
+	f.tz, f.hasZone = ZULU, true
+
+	t, err := p.resolve(s, f)
+	if err != nil {
+		return Date{}, err
+	}
+
+	return DateOf(t), nil
+}
+
+// parseDate returns the day, month and year of a free-form date.
+func (p Parser) parseDate(s string) (dtgFields, error) {
+	var f dtgFields
+
+	n := digitLen(s, 0)
+	switch {
+	case n == 0:
+		return dtgFields{}, layoutMissing(s, 0, ComponentDay)
+	case n > 2:
+		return dtgFields{}, newParseError(s, 0, ComponentDay, ErrInvalidDateTimeGroup,
+			"day %q must have one or two digits", s[:n])
+	}
+
+	f.day, _ = strictDigits(s, 0, n)
+	i := skipSpaces(s, n)
+
+	n = layoutWordLen(s, i)
+	if n == 0 {
+		return dtgFields{}, layoutMissing(s, i, ComponentMonth)
+	}
+
+	m, ok := months[strings.ToUpper(s[i:i+n])]
+	if !ok {
+		return dtgFields{}, newParseError(s, i, ComponentMonth, ErrInvalidMonth,
+			"unknown month %q", s[i:i+n])
+	}
+
+	f.month, f.hasMonth = m, true
+	i = skipSpaces(s, i+n)
+
+	f.yearPos = i
+	switch n = digitLen(s, i); n {
+	case 2:
+		y, _ := strictDigits(s, i, 2)
+		f.year = p.expandYear(y, p.reference().UTC().Year())
+	case 4:
+		f.year, _ = strictDigits(s, i, 4)
+	case 0:
+		return dtgFields{}, layoutMissing(s, i, ComponentYear)
+	default:
+		return dtgFields{}, newParseError(s, i, ComponentYear, ErrInvalidDateTimeGroup,
+			"year %q must have two or four digits", s[i:i+n])
+	}
+
+	f.hasYear = true
+
+	if i += n; i < len(s) {
+		r, _ := utf8.DecodeRuneInString(s[i:])
+		return dtgFields{}, newParseError(s, i, ComponentNone, ErrInvalidDateTimeGroup,
+			"unexpected character %q after year", r)
+	}
+
+	return f, nil
+}
+
+// IsZero reports whether d is the zero Date.
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// IsValid reports whether d is a date that exists, such as
+// February 29 only in leap years.
+func (d Date) IsValid() bool {
+	return d.Month >= time.January && d.Month <= time.December &&
+		d.Day >= 1 && d.Day <= daysInMonth(d.Month, d.Year)
+}
+
+// In returns the Time at the start of d in the time zone tz.
+// Juliet times are in time.Local.
+func (d Date) In(tz TimeZone) Time {
+	return NewTime(time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, tz.Location()))
+}
+
+// At returns the Time of tod on d, in the time zone of tod. A TimeOfDay
+// of 2400 is midnight at the end of d.
+func (d Date) At(tod TimeOfDay) Time {
+	return NewTime(time.Date(d.Year, d.Month, d.Day, tod.Hour, tod.Minute, tod.Second, 0,
+		tod.zone().Location()))
+}
+
+// Format returns d formatted according to layout, using the date tokens
+// described for Time.Format, such as MILDATE or "DDMMMYY". Dates that do
+// not exist and years the zero Formatter rejects are written as
+// "INVALID DATE".
+func (d Date) Format(layout string) string {
+	b, err := d.appendFormat(make([]byte, 0, 16), layout)
+	if err != nil {
+		return invalidDate
+	}
+
+	return string(b)
+}
+
+// String returns the date in the MILDATE layout, such as "15 JAN 2024".
+func (d Date) String() string {
+	return d.Format(MILDATE)
+}
+
+// validate returns ErrInvalidDay if d does not exist.
+func (d Date) validate() error {
+	if !d.IsValid() {
+		return fmt.Errorf("%w: %04d-%02d-%02d does not exist", ErrInvalidDay, d.Year, int(d.Month), d.Day)
+	}
+
+	return nil
+}
+
+// appendFormat appends d formatted according to layout to b.
+func (d Date) appendFormat(b []byte, layout string) ([]byte, error) {
+	if err := d.validate(); err != nil {
+		return b, err
+	}
+
+	return Formatter{}.AppendFormat(b, d.In(ZULU), layout)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The date is a quoted string in the MILDATE layout, and the zero Date
+// is encoded as null.
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return jsonNull, nil
+	}
+
+	b := make([]byte, 0, 16)
+	b = append(b, '"')
+	b, err := d.appendFormat(b, MILDATE)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(b, '"'), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The date must be a quoted string accepted by ParseDate, an empty string
+// or null. Both null and the empty string leave the zero Date.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, jsonNull) {
+		*d = Date{}
+		return nil
+	}
+
+	text, err := unquoteJSON(data, "mildtg.Date")
+	if err != nil {
+		return err
+	}
+
+	return d.UnmarshalText(text)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The date is formatted in the MILDATE layout, and the zero Date is
+// encoded as an empty string.
+func (d Date) MarshalText() ([]byte, error) {
+	if d.IsZero() {
+		return []byte{}, nil
+	}
+
+	return d.appendFormat(nil, MILDATE)
+}
+
+// AppendText implements the encoding.TextAppender interface.
+// It appends the same encoding as MarshalText to b.
+func (d Date) AppendText(b []byte) ([]byte, error) {
+	if d.IsZero() {
+		return b, nil
+	}
+
+	return d.appendFormat(b, MILDATE)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The date must be accepted by ParseDate or empty.
+func (d *Date) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*d = Date{}
+		return nil
+	}
+
+	out, err := ParseDate(string(data))
+	if err != nil {
+		return err
+	}
+
+	*d = out
+
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// The encoding is the same as MarshalText.
+func (d Date) MarshalBinary() ([]byte, error) {
+	return d.MarshalText()
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface.
+// The encoding is the same as AppendText.
+func (d Date) AppendBinary(b []byte) ([]byte, error) {
+	return d.AppendText(b)
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// The encoding is the same as UnmarshalText.
+func (d *Date) UnmarshalBinary(data []byte) error {
+	return d.UnmarshalText(data)
+}
+
+// digitLen returns the number of ASCII digits at offset i in s.
+func digitLen(s string, i int) int {
+	n := 0
+	for i+n < len(s) && isDigit(s[i+n]) {
+		n++
+	}
+
+	return n
+}
+
+// skipSpaces returns the offset of the first non-space at or after i in s.
+func skipSpaces(s string, i int) int {
+	for i < len(s) && s[i] == ' ' {
+		i++
+	}
+
+	return i
+}
@@ -0,0 +1,251 @@
+package mildtg
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParser_ParseDate(t *testing.T) {
+	t.Parallel()
+
+	reference := time.Date(2024, 3, 10, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		parser Parser
+		input  string
+		want   Date
+		error  error
+	}{
+		{name: "spaced short year", input: "15 JAN 24", want: Date{2024, time.January, 15}},
+		{name: "compact full year", input: "15JAN2024", want: Date{2024, time.January, 15}},
+		{name: "one-digit day", input: "5 JAN 24", want: Date{2024, time.January, 5}},
+		{name: "full month name", input: "05 January 2024", want: Date{2024, time.January, 5}},
+		{name: "lower case", input: "29feb24", want: Date{2024, time.February, 29}},
+		{name: "extra spaces", input: "01  DEC   99", want: Date{1999, time.December, 1}},
+		{
+			name:   "century window",
+			parser: Parser{CenturyWindow: 20},
+			input:  "01 JAN 45",
+			want:   Date{1945, time.January, 1},
+		},
+		{name: "leap day in a common year", input: "29 FEB 23", error: ErrInvalidDay},
+		{name: "day zero", input: "00 JAN 24", error: ErrInvalidDay},
+		{name: "unknown month", input: "15 JAM 24", error: ErrInvalidMonth},
+		{name: "year out of range", input: "15 JAN 1900", error: ErrYearOutOfRange},
+		{name: "three-digit day", input: "150 JAN 24", error: ErrInvalidDateTimeGroup},
+		{name: "three-digit year", input: "15 JAN 202", error: ErrInvalidDateTimeGroup},
+		{name: "missing year", input: "15 JAN", error: ErrNotEnoughChars},
+		{name: "trailing text", input: "15 JAN 24Z", error: ErrInvalidDateTimeGroup},
+		{name: "empty", input: "", error: ErrNotEnoughChars},
+		{name: "strict", parser: Parser{Strict: true}, input: "15 JAN 2024", want: Date{2024, time.January, 15}},
+		{name: "strict one-digit day", parser: Parser{Strict: true}, input: "5 JAN 24", error: ErrInvalidDateTimeGroup},
+		{name: "strict compact", parser: Parser{Strict: true}, input: "15JAN24", error: ErrInvalidDateTimeGroup},
+		{name: "strict lower case", parser: Parser{Strict: true}, input: "15 jan 24", error: ErrInvalidDateTimeGroup},
+		{name: "strict full month name", parser: Parser{Strict: true}, input: "15 JANUARY 24", error: ErrInvalidMonth},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := tt.parser
+			p.Reference = reference
+
+			got, err := p.ParseDate(tt.input)
+			if !errors.Is(err, tt.error) {
+				t.Fatalf("got %v, want %v", err, tt.error)
+			}
+
+			if err != nil {
+				var pe *ParseError
+				if !errors.As(err, &pe) {
+					t.Errorf("got %T, want *ParseError", err)
+				}
+			}
+
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDate_Format(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		input  Date
+		layout string
+		want   string
+	}{
+		{name: "MILDATE", input: Date{2024, time.January, 15}, layout: MILDATE, want: "15 JAN 2024"},
+		{name: "compact", input: Date{2024, time.January, 15}, layout: "DDMMMYY", want: "15JAN24"},
+		{name: "julian", input: Date{2024, time.February, 29}, layout: "YDDD", want: "4060"},
+		{name: "nonexistent", input: Date{2023, time.February, 29}, layout: MILDATE, want: invalidDate},
+		{name: "zero", input: Date{}, layout: MILDATE, want: invalidDate},
+		{name: "year out of range", input: Date{1900, time.January, 1}, layout: MILDATE, want: invalidDate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.Format(tt.layout); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := (Date{2024, time.January, 15}).String(); got != "15 JAN 2024" {
+		t.Errorf("got %v, want %v", got, "15 JAN 2024")
+	}
+}
+
+func TestDate_Conversions(t *testing.T) {
+	t.Parallel()
+
+	d := Date{2024, time.December, 31}
+
+	tests := []struct {
+		name string
+		got  Time
+		want time.Time
+	}{
+		{
+			name: "In",
+			got:  d.In(ROMEO),
+			want: time.Date(2024, 12, 31, 0, 0, 0, 0, ROMEO.Location()),
+		},
+		{
+			name: "At",
+			got:  d.At(TimeOfDay{Hour: 14, Minute: 30, Zone: ROMEO}),
+			want: time.Date(2024, 12, 31, 14, 30, 0, 0, ROMEO.Location()),
+		},
+		{
+			name: "At zero zone",
+			got:  d.At(TimeOfDay{Hour: 14, Minute: 30, Second: 15}),
+			want: time.Date(2024, 12, 31, 14, 30, 15, 0, ZULU.Location()),
+		},
+		{
+			name: "At 2400",
+			got:  d.At(TimeOfDay{Hour: 24, Zone: ZULU}),
+			want: time.Date(2025, 1, 1, 0, 0, 0, 0, ZULU.Location()),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.got.Equal(tt.want) || tt.got.Location() != tt.want.Location() {
+				t.Errorf("got %v, want %v", tt.got.Time, tt.want)
+			}
+		})
+	}
+
+	if got := d.At(TimeOfDay{Hour: 14, Minute: 30, Zone: ROMEO}).String(); got != "311430R DEC 24" {
+		t.Errorf("got %v, want %v", got, "311430R DEC 24")
+	}
+
+	if got := DateOf(NewTime(time.Date(2024, 12, 31, 23, 0, 0, 0, ROMEO.Location()))); got != d {
+		t.Errorf("got %v, want %v", got, d)
+	}
+}
+
+func TestDate_IsValid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input Date
+		want  bool
+	}{
+		{input: Date{2024, time.February, 29}, want: true},
+		{input: Date{2023, time.February, 29}, want: false},
+		{input: Date{2024, time.April, 31}, want: false},
+		{input: Date{2024, 13, 1}, want: false},
+		{input: Date{}, want: false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.input.IsValid(); got != tt.want {
+			t.Errorf("%#v: got %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestDate_JSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	type record struct {
+		Issued Date  `json:"issued"`
+		Zero   Date  `json:"zero"`
+		Due    *Date `json:"due,omitempty"`
+	}
+
+	in := record{Issued: Date{2024, time.January, 15}}
+
+	b, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if want := `{"issued":"15 JAN 2024","zero":null}`; string(b) != want {
+		t.Errorf("got %s, want %s", b, want)
+	}
+
+	var out record
+	if err := json.Unmarshal(b, &out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if out != in {
+		t.Errorf("got %v, want %v", out, in)
+	}
+
+	if err := json.Unmarshal([]byte(`{"issued":"15 \u004aAN 2024"}`), &out); err != nil || out.Issued != in.Issued {
+		t.Errorf("got %v, %v, want %v", out.Issued, err, in.Issued)
+	}
+
+	if err := json.Unmarshal([]byte(`{"issued":15}`), &out); !errors.Is(err, ErrInvalidDateTimeGroup) {
+		t.Errorf("got %v, want %v", err, ErrInvalidDateTimeGroup)
+	}
+
+	if _, err := json.Marshal(record{Issued: Date{2023, time.February, 29}}); !errors.Is(err, ErrInvalidDay) {
+		t.Errorf("got %v, want %v", err, ErrInvalidDay)
+	}
+}
+
+func TestDate_MarshalBinary(t *testing.T) {
+	t.Parallel()
+
+	in := Date{2024, time.February, 29}
+
+	b, err := in.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if string(b) != "29 FEB 2024" {
+		t.Errorf("got %s, want %s", b, "29 FEB 2024")
+	}
+
+	var out Date
+	if err := out.UnmarshalBinary(b); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if out != in {
+		t.Errorf("got %v, want %v", out, in)
+	}
+
+	b, err = in.AppendText([]byte("due "))
+	if err != nil || string(b) != "due 29 FEB 2024" {
+		t.Errorf("got %s, %v, want %s", b, err, "due 29 FEB 2024")
+	}
+
+	if b, err = (Date{}).MarshalText(); err != nil || len(b) != 0 {
+		t.Errorf("got %q, %v, want empty", b, err)
+	}
+
+	if err := out.UnmarshalText(nil); err != nil || !out.IsZero() {
+		t.Errorf("got %v, %v, want zero Date", out, err)
+	}
+}
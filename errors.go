@@ -0,0 +1,83 @@
+package mildtg
+
+import (
+	"fmt"
+)
+
+// Component identifies a field of a date-time-group.
+type Component int
+
+const (
+	// ComponentNone is used for errors that do not belong to a single field,
+	// such as an unexpected character or a date-time-group that is too short.
+	ComponentNone Component = iota
+	ComponentDay
+	ComponentHour
+	ComponentMinute
+	ComponentSeconds
+	ComponentZone
+	ComponentMonth
+	ComponentYear
+)
+
+// String returns the lower-case name of the component.
+func (c Component) String() string {
+	switch c {
+	case ComponentDay:
+		return "day"
+	case ComponentHour:
+		return "hour"
+	case ComponentMinute:
+		return "minute"
+	case ComponentSeconds:
+		return "seconds"
+	case ComponentZone:
+		return "zone"
+	case ComponentMonth:
+		return "month"
+	case ComponentYear:
+		return "year"
+	default:
+		return "date-time-group"
+	}
+}
+
+// ParseError describes a problem parsing a date-time-group.
+//
+// Err holds the sentinel error for the problem, such as ErrInvalidDay or
+// ErrInvalidMonth, so errors.Is reports it. Every ParseError also matches
+// ErrInvalidDateTimeGroup.
+type ParseError struct {
+	Input     string    // the input being parsed
+	Offset    int       // byte offset in Input where the problem was found
+	Component Component // the field that failed to parse
+	Message   string    // description of the problem, such as `hour "25" out of range`
+	Err       error     // the sentinel error for the problem
+}
+
+// Error returns the string representation of a ParseError.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("parsing date-time-group %q at offset %d: %s", e.Input, e.Offset, e.Message)
+}
+
+// Unwrap returns the sentinel error for the problem.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is ErrInvalidDateTimeGroup, which
+// every ParseError matches.
+func (e *ParseError) Is(target error) bool {
+	return target == ErrInvalidDateTimeGroup
+}
+
+// newParseError returns a *ParseError for the component at offset in s.
+func newParseError(s string, offset int, c Component, err error, format string, args ...interface{}) *ParseError {
+	return &ParseError{
+		Input:     s,
+		Offset:    offset,
+		Component: c,
+		Message:   fmt.Sprintf(format, args...),
+		Err:       err,
+	}
+}
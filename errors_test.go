@@ -0,0 +1,203 @@
+package mildtg
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		input     string
+		offset    int
+		component Component
+		message   string
+		error     error
+	}{
+		{
+			name:      "hour out of range",
+			input:     "012500Z JAN 24",
+			offset:    2,
+			component: ComponentHour,
+			message:   `hour "25" out of range`,
+			error:     ErrInvalidDateTimeGroup,
+		},
+		{
+			name:      "minute out of range",
+			input:     "01 01 60Z JAN 24",
+			offset:    6,
+			component: ComponentMinute,
+			message:   `minute "60" out of range`,
+			error:     ErrInvalidDateTimeGroup,
+		},
+		{
+			name:      "seconds out of range",
+			input:     "01010099Z JAN 24",
+			offset:    6,
+			component: ComponentSeconds,
+			message:   `seconds "99" out of range`,
+			error:     ErrInvalidDateTimeGroup,
+		},
+		{
+			name:      "day out of range",
+			input:     "310100Z FEB 21",
+			offset:    0,
+			component: ComponentDay,
+			message:   `day "31" out of range for February 2021`,
+			error:     ErrInvalidDay,
+		},
+		{
+			name:      "unknown month",
+			input:     "010100Z JEN 24",
+			offset:    8,
+			component: ComponentMonth,
+			message:   `unknown month "JEN"`,
+			error:     ErrInvalidMonth,
+		},
+		{
+			name:      "unknown month without zone",
+			input:     "010100 JEN 24",
+			offset:    7,
+			component: ComponentMonth,
+			message:   `unknown month "JEN"`,
+			error:     ErrInvalidMonth,
+		},
+		{
+			name:      "unknown zone with suffix",
+			input:     "010100Z* JAN 24",
+			offset:    6,
+			component: ComponentZone,
+			message:   `unknown time zone "Z*"`,
+			error:     ErrInvalidDateTimeGroup,
+		},
+		{
+			name:      "odd year digits",
+			input:     "010100Z JAN 202",
+			offset:    12,
+			component: ComponentYear,
+			message:   `year "202" must have two or four digits`,
+			error:     ErrInvalidDateTimeGroup,
+		},
+		{
+			name:      "unexpected character",
+			input:     "010100Z-JAN-24",
+			offset:    7,
+			component: ComponentNone,
+			message:   `unexpected character '-'`,
+			error:     ErrInvalidDateTimeGroup,
+		},
+		{
+			name:      "too short",
+			input:     "0101",
+			offset:    4,
+			component: ComponentNone,
+			message:   `expected DDHHMM followed by pairs of digits, found 4 digits`,
+			error:     ErrNotEnoughChars,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseDTG(tt.input)
+
+			var pe *ParseError
+			if !errors.As(err, &pe) {
+				t.Fatalf("got %T, want *ParseError", err)
+			}
+
+			if pe.Input != tt.input {
+				t.Errorf("got input %q, want %q", pe.Input, tt.input)
+			}
+
+			if pe.Offset != tt.offset {
+				t.Errorf("got offset %d, want %d", pe.Offset, tt.offset)
+			}
+
+			if pe.Component != tt.component {
+				t.Errorf("got component %v, want %v", pe.Component, tt.component)
+			}
+
+			if pe.Message != tt.message {
+				t.Errorf("got message %q, want %q", pe.Message, tt.message)
+			}
+
+			if !errors.Is(err, tt.error) {
+				t.Errorf("got %v, want %v", err, tt.error)
+			}
+
+			if !errors.Is(err, ErrInvalidDateTimeGroup) {
+				t.Errorf("got %v, want it to match %v", err, ErrInvalidDateTimeGroup)
+			}
+		})
+	}
+}
+
+func TestParseError_Juliet(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone database unavailable: %v", err)
+	}
+
+	_, err = ParseDTGInLocation("100230J MAR 2024", newYork)
+
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		t.Fatalf("got %T, want *ParseError", err)
+	}
+
+	if pe.Component != ComponentZone || pe.Offset != 6 {
+		t.Errorf("got %v at %d, want zone at 6", pe.Component, pe.Offset)
+	}
+
+	if !errors.Is(err, ErrNonexistentLocalTime) {
+		t.Errorf("got %v, want %v", err, ErrNonexistentLocalTime)
+	}
+}
+
+func TestParseError_Error(t *testing.T) {
+	t.Parallel()
+
+	err := &ParseError{
+		Input:     "012500Z JAN 24",
+		Offset:    2,
+		Component: ComponentHour,
+		Message:   `hour "25" out of range`,
+		Err:       ErrInvalidDateTimeGroup,
+	}
+
+	want := `parsing date-time-group "012500Z JAN 24" at offset 2: hour "25" out of range`
+	if err.Error() != want {
+		t.Errorf("got %v, want %v", err.Error(), want)
+	}
+}
+
+func TestComponent_String(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input Component
+		want  string
+	}{
+		{input: ComponentNone, want: "date-time-group"},
+		{input: ComponentDay, want: "day"},
+		{input: ComponentHour, want: "hour"},
+		{input: ComponentMinute, want: "minute"},
+		{input: ComponentSeconds, want: "seconds"},
+		{input: ComponentZone, want: "zone"},
+		{input: ComponentMonth, want: "month"},
+		{input: ComponentYear, want: "year"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.input.String(); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
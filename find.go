@@ -0,0 +1,279 @@
+package mildtg
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// Confidence rates how likely a Match is to be a date-time-group rather
+// than some other text that happens to parse as one.
+type Confidence int
+
+const (
+	// ConfidenceLow is a match with only one of a zone or a month,
+	// such as "011200Z" or "011200 JAN".
+	ConfidenceLow Confidence = iota + 1
+
+	// ConfidenceMedium is a match with two of a zone, a month and a year,
+	// such as "011200Z JAN" or "011200 JAN 24".
+	ConfidenceMedium
+
+	// ConfidenceHigh is a match with a zone, a month and a year,
+	// such as "011200Z JAN 24".
+	ConfidenceHigh
+)
+
+// String returns the name of the level, such as "low".
+func (c Confidence) String() string {
+	switch c {
+	case ConfidenceLow:
+		return "low"
+	case ConfidenceMedium:
+		return "medium"
+	case ConfidenceHigh:
+		return "high"
+	default:
+		return "unknown"
+	}
+}
+
+// Match is a date-time-group found in text.
+type Match struct {
+	// Start and End are the byte offsets of the match, so that the
+	// date-time-group is text[Start:End].
+	Start int
+	End   int
+
+	// Text is the date-time-group as written.
+	Text string
+
+	// Time is the parsed date-time-group.
+	Time Time
+
+	// Confidence rates how likely the match is a date-time-group.
+	Confidence Confidence
+}
+
+// FindAllDTG returns every date-time-group in s, in order.
+// It is equivalent to Parser{}.FindAll(s).
+func FindAllDTG(s string) []Match {
+	return Parser{}.FindAll(s)
+}
+
+// FindAll returns every date-time-group in s, in order, parsed with p.
+//
+// A date-time-group is found as a run of six or eight digits that is not
+// part of a longer word, followed by an upper-case zone letter, a month
+// or both, and then optionally a two- or four-digit year. Months may be
+// in any case and separated from the rest by spaces, except that a month
+// separated from digits without a zone must be upper case or followed by
+// a year, so that prose such as "Ticket 102030 may be closed" is not
+// matched. Plain numbers without a zone or month, such as "241200", are
+// never matched, nor are candidates that do not parse, such as "312500Z".
+func (p Parser) FindAll(s string) []Match {
+	p = p.withReference()
+
+	var matches []Match
+	for i := 0; i < len(s); {
+		m, next, ok := p.findAt(s, i)
+		if ok {
+			matches = append(matches, m)
+		}
+
+		i = next
+	}
+
+	return matches
+}
+
+// findAt looks for a date-time-group starting at offset i in s. It returns
+// the match, if any, and the offset from which to continue searching.
+func (p Parser) findAt(s string, i int) (Match, int, bool) {
+	if !isDigit(s[i]) || i > 0 && isWordByte(s[i-1]) {
+		return Match{}, i + 1, false
+	}
+
+	// Day, hour and minute, then optional seconds.
+	end := i
+	for end < len(s) && isDigit(s[end]) {
+		end++
+	}
+
+	next := end
+	if n := end - i; n != 6 && n != 8 {
+		return Match{}, next, false
+	}
+
+	var hasZone, hasMonth, hasYear bool
+
+	// A zone letter, with any suffix, and a month may follow the digits
+	// directly, as in "011200ZJAN".
+	if n := layoutWordLen(s, end); n > 0 {
+		word := s[end : end+n]
+		switch {
+		case n == 1 || isMonth(word[1:]):
+			if word[0] < 'A' || word[0] > 'Z' {
+				return Match{}, next, false
+			}
+
+			hasZone = true
+			hasMonth = n > 1
+			if n == 1 {
+				n = layoutDesignatorLen(s, end)
+			}
+		case isMonth(word):
+			hasMonth = true
+		default:
+			return Match{}, next, false
+		}
+
+		end += n
+	}
+
+	// A month separated by spaces, which in prose may be an ordinary
+	// word such as "may" or "march".
+	var spacedMonth string
+	if !hasMonth {
+		n := findSpaces(s, end)
+		m := layoutWordLen(s, end+n)
+		if m > 0 && isMonth(s[end+n:end+n+m]) {
+			hasMonth = true
+			spacedMonth = s[end+n : end+n+m]
+			end += n + m
+		}
+	}
+
+	if !hasZone && !hasMonth {
+		return Match{}, next, false
+	}
+
+	if hasMonth {
+		n := findSpaces(s, end)
+		m := 0
+		for end+n+m < len(s) && isDigit(s[end+n+m]) {
+			m++
+		}
+
+		if (m == 2 || m == 4) && (end+n+m == len(s) || !isWordByte(s[end+n+m])) {
+			hasYear = true
+			end += n + m
+		}
+	}
+
+	if end < len(s) && isWordByte(s[end]) {
+		return Match{}, next, false
+	}
+
+	if !hasZone && !hasYear && spacedMonth != "" && spacedMonth != strings.ToUpper(spacedMonth) {
+		return Match{}, next, false
+	}
+
+	t, err := p.Parse(s[i:end])
+	if err != nil {
+		return Match{}, next, false
+	}
+
+	// One level of confidence for each of the zone, month and year.
+	var c Confidence
+	for _, given := range [...]bool{hasZone, hasMonth, hasYear} {
+		if given {
+			c++
+		}
+	}
+
+	return Match{Start: i, End: end, Text: s[i:end], Time: t, Confidence: c}, end, true
+}
+
+// findSpaces returns the number of spaces at offset i in s.
+func findSpaces(s string, i int) int {
+	n := 0
+	for i+n < len(s) && s[i+n] == ' ' {
+		n++
+	}
+
+	return n
+}
+
+// isMonth reports whether word is a month name or abbreviation in any case.
+func isMonth(word string) bool {
+	_, ok := months[strings.ToUpper(word)]
+	return ok
+}
+
+// isWordByte reports whether c is an ASCII letter or digit.
+func isWordByte(c byte) bool {
+	return isLetter(c) || isDigit(c)
+}
+
+// Scanner finds the date-time-groups in text read from an io.Reader,
+// one line at a time. A date-time-group split across lines is not found.
+//
+//	sc := mildtg.NewScanner(r)
+//	for sc.Scan() {
+//		m := sc.Match()
+//		fmt.Println(m.Start, m.Time)
+//	}
+//	if err := sc.Err(); err != nil {
+//		return err
+//	}
+type Scanner struct {
+	// Parser parses each date-time-group found. It may be set before the
+	// first call to Scan.
+	Parser Parser
+
+	r       *bufio.Reader
+	offset  int
+	pending []Match
+	match   Match
+	err     error
+	done    bool
+}
+
+// NewScanner returns a Scanner reading from r.
+func NewScanner(r io.Reader) *Scanner {
+	return &Scanner{r: bufio.NewReader(r)}
+}
+
+// Scan advances to the next date-time-group, which is then available
+// through Match. It returns false at the end of the input or on a read
+// error, which is then available through Err.
+func (sc *Scanner) Scan() bool {
+	for len(sc.pending) == 0 {
+		if sc.done {
+			return false
+		}
+
+		line, err := sc.r.ReadString('\n')
+		switch {
+		case err == io.EOF:
+			sc.done = true
+		case err != nil:
+			sc.done, sc.err = true, err
+			return false
+		}
+
+		sc.pending = sc.Parser.FindAll(line)
+		for i := range sc.pending {
+			sc.pending[i].Start += sc.offset
+			sc.pending[i].End += sc.offset
+		}
+
+		sc.offset += len(line)
+	}
+
+	sc.match, sc.pending = sc.pending[0], sc.pending[1:]
+
+	return true
+}
+
+// Match returns the date-time-group found by the last call to Scan.
+// Its offsets count bytes from the start of the input.
+func (sc *Scanner) Match() Match {
+	return sc.match
+}
+
+// Err returns the first error other than io.EOF encountered by Scan.
+func (sc *Scanner) Err() error {
+	return sc.err
+}
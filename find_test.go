@@ -0,0 +1,199 @@
+package mildtg
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParser_FindAll(t *testing.T) {
+	t.Parallel()
+
+	p := Parser{Reference: time.Date(2024, 3, 10, 15, 0, 0, 0, time.UTC)}
+
+	type found struct {
+		start, end int
+		time       time.Time
+		confidence Confidence
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		want  []found
+	}{
+		{
+			name:  "sitrep",
+			input: "SITREP AS OF 011200Z JAN 24. NEXT REPORT NLT 021200Z.",
+			want: []found{
+				{13, 27, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC), ConfidenceHigh},
+				{45, 52, time.Date(2024, 3, 2, 12, 0, 0, 0, time.UTC), ConfidenceLow},
+			},
+		},
+		{
+			name:  "compact with seconds and suffix",
+			input: "(01120030E*JAN2024)",
+			want: []found{
+				{1, 18, time.Date(2024, 1, 1, 6, 30, 30, 0, time.UTC), ConfidenceHigh},
+			},
+		},
+		{
+			name:  "month without zone",
+			input: "moved 151200 mar 2024 and 161200 MAR",
+			want: []found{
+				{6, 21, time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC), ConfidenceMedium},
+				{26, 36, time.Date(2024, 3, 16, 12, 0, 0, 0, time.UTC), ConfidenceLow},
+			},
+		},
+		{
+			name:  "zone and month without year",
+			input: "ETA 051200R MAR",
+			want: []found{
+				{4, 15, time.Date(2024, 3, 5, 17, 0, 0, 0, time.UTC), ConfidenceMedium},
+			},
+		},
+		{
+			name:  "year that is not a year",
+			input: "011200Z JAN 20245",
+			want: []found{
+				{0, 11, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC), ConfidenceMedium},
+			},
+		},
+		{
+			name:  "plain numbers",
+			input: "call 555123 or 241200 then wire 12345678 and 100000m",
+		},
+		{
+			name:  "part of a longer word",
+			input: "ref A011200Z, 011200ZULU, 0112000Z, 011200Z1",
+		},
+		{
+			name:  "month word in prose",
+			input: "Ticket 102030 may be closed. Invoice 150000 march on, 161200 Mar",
+		},
+		{
+			name:  "attached month in any case",
+			input: "moved 161200mar",
+			want: []found{
+				{6, 15, time.Date(2024, 3, 16, 12, 0, 0, 0, time.UTC), ConfidenceLow},
+			},
+		},
+		{
+			name:  "does not parse",
+			input: "312500Z JAN 24 and 300000Z FEB 24",
+		},
+		{
+			name:  "full month",
+			input: "at 011200Z JANUARY 2024",
+			want: []found{
+				{3, 23, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC), ConfidenceHigh},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := p.FindAll(tt.input)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d matches %v, want %d", len(got), got, len(tt.want))
+			}
+
+			for i, m := range got {
+				w := tt.want[i]
+				if m.Start != w.start || m.End != w.end {
+					t.Errorf("got offsets %d-%d, want %d-%d", m.Start, m.End, w.start, w.end)
+				}
+
+				if m.Text != tt.input[w.start:w.end] {
+					t.Errorf("got %q, want %q", m.Text, tt.input[w.start:w.end])
+				}
+
+				if !m.Time.Equal(w.time) {
+					t.Errorf("got %v, want %v", m.Time.Time, w.time)
+				}
+
+				if m.Confidence != w.confidence {
+					t.Errorf("got %v, want %v", m.Confidence, w.confidence)
+				}
+			}
+		})
+	}
+}
+
+func TestFindAllDTG(t *testing.T) {
+	t.Parallel()
+
+	got := FindAllDTG("SENT 011200Z JAN 2024")
+	if len(got) != 1 || got[0].Text != "011200Z JAN 2024" {
+		t.Errorf("got %v, want one match", got)
+	}
+}
+
+func TestScanner(t *testing.T) {
+	t.Parallel()
+
+	input := "LINE ONE 011200Z JAN 24\r\nNOTHING HERE\nTWO 021200Z JAN 24 AND 031200Z JAN 24"
+
+	sc := NewScanner(strings.NewReader(input))
+
+	var got []string
+	for sc.Scan() {
+		m := sc.Match()
+		if input[m.Start:m.End] != m.Text {
+			t.Errorf("got %q at %d-%d, want %q", input[m.Start:m.End], m.Start, m.End, m.Text)
+		}
+
+		got = append(got, m.Time.Format(MILDTGFULLYEAR))
+	}
+
+	if err := sc.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"011200Z JAN 2024", "021200Z JAN 2024", "031200Z JAN 2024"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) {
+	return 0, errors.New("read failed")
+}
+
+func TestScanner_Err(t *testing.T) {
+	t.Parallel()
+
+	sc := NewScanner(errReader{})
+	if sc.Scan() {
+		t.Errorf("got a match, want none")
+	}
+
+	if sc.Err() == nil {
+		t.Errorf("got nil, want an error")
+	}
+}
+
+func TestConfidence_String(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input Confidence
+		want  string
+	}{
+		{input: ConfidenceLow, want: "low"},
+		{input: ConfidenceMedium, want: "medium"},
+		{input: ConfidenceHigh, want: "high"},
+		{input: Confidence(0), want: "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.input.String(); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,241 @@
+package mildtg
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ErrNoZoneLetter is returned when a time's UTC offset does not
+// correspond to a military time zone letter.
+var ErrNoZoneLetter = errors.New("no military time zone letter for offset")
+
+// ZonePolicy controls how a Formatter handles a time whose UTC offset
+// does not correspond to a military time zone letter.
+type ZonePolicy int
+
+const (
+	// ZonePolicyZulu converts the time to Zulu before formatting it.
+	ZonePolicyZulu ZonePolicy = iota
+
+	// ZonePolicyError rejects the time with ErrNoZoneLetter.
+	ZonePolicyError
+)
+
+// Formatter formats Time values as date-time-groups.
+//
+// The zone letter is derived from the UTC offset in effect at the instant
+// being formatted, so times in locations such as America/New_York are
+// written with the letter matching their standard or daylight saving
+// offset. The zero value converts times without a matching letter to Zulu
+// and is what Time.Format and Time.String use.
+type Formatter struct {
+	// ZonePolicy selects what happens when no letter matches the offset.
+	ZonePolicy ZonePolicy
+
+	// Juliet is the location written with the Juliet (J) designator,
+	// matching the location given to ParseDTGInLocation. If nil, no time
+	// is written with J, not even one in time.Local, since a J read on
+	// another host would name a different local time.
+	Juliet *time.Location
+
+	// MinYear and MaxYear bound the formatted year, inclusive, as for
+	// Parser. If zero, MinYear is 1941 and MaxYear is 9999.
+	MinYear int
+	MaxYear int
+
+	// CenturyWindow and Reference place two-digit years as for Parser, so
+	// that a formatter built from a parser writes only the short years that
+	// parser reads back. If CenturyWindow is zero, years 1969 through 2068
+	// may be written with two digits, and if it is outside 0 through 99,
+	// none may. If Reference is zero, the current time of the package Clock
+	// is used.
+	CenturyWindow int
+	Reference     time.Time
+}
+
+// Format returns t formatted according to layout.
+// The MILDTGFULLYEAR and MILDTGSHORTYEAR constants and layouts written
+// with the date-time-group tokens described for Time.Format are
+// written as date-time-groups; any other layout is passed to
+// time.Time.Format. Times in the formatter's Juliet location, if set,
+// are written with the J designator.
+//
+// A date-time-group whose year is outside the formatter's bounds is
+// rejected with ErrYearOutOfRange. So is a two-digit year that a Parser
+// with the formatter's CenturyWindow and Reference would read back in
+// another century. For the zero Formatter, as for ParseDTG, that is any
+// year outside 1969 through 2068.
+func (f Formatter) Format(t Time, layout string) (string, error) {
+	b, err := f.AppendFormat(make([]byte, 0, 32), t, layout)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// AppendFormat is like Format but appends the textual representation
+// to b and returns the extended buffer. It does not allocate when b
+// has enough capacity.
+func (f Formatter) AppendFormat(b []byte, t Time, layout string) ([]byte, error) {
+	layout, ok := dtgLayout(layout)
+	if !ok {
+		return t.Time.AppendFormat(b, layout), nil
+	}
+
+	if t.IsZero() {
+		return append(b, invalidDTG...), nil
+	}
+
+	tz, ok := JULIET, true
+	if f.Juliet == nil || t.Location() != f.Juliet {
+		tz, ok = zoneOf(t.Time)
+	}
+
+	if !ok {
+		switch f.ZonePolicy {
+		case ZonePolicyZulu:
+			tz = ZULU
+			t = NewTime(t.Time.In(ZULU.Location()))
+		default:
+			_, offset := t.Zone()
+			return b, fmt.Errorf("%w: %v", ErrNoZoneLetter, time.Duration(offset)*time.Second)
+		}
+	}
+
+	return f.appendLayout(b, t.Time, tz, layout)
+}
+
+// isShortYear reports whether year reads back as itself when written
+// with two digits, using the formatter's CenturyWindow and Reference.
+func (f Formatter) isShortYear(year int) bool {
+	if year < 0 || f.CenturyWindow < 0 || f.CenturyWindow > 99 {
+		return false
+	}
+
+	p := Parser{Reference: f.Reference, CenturyWindow: f.CenturyWindow}
+
+	refYear := 0
+	if p.CenturyWindow != 0 {
+		refYear = p.reference().UTC().Year()
+	}
+
+	return p.expandYear(year%100, refYear) == year
+}
+
+// zoneOf returns the military time zone for the UTC offset in effect at t.
+// A location already named after a designation whose offset matches keeps
+// that designation. Juliet is never reported, since it has no fixed offset.
+func zoneOf(t time.Time) (TimeZone, bool) {
+	name, offset := t.Zone()
+
+	if tz, ok := ZoneByDesignator(name); ok && int(tz.offset) == offset {
+		return tz, true
+	}
+
+	return ZoneByOffset(offset)
+}
+
+// DTG is a Time that implements fmt.Formatter, so that templates and
+// log lines written with fmt can choose the date-time-group form.
+// Time cannot implement fmt.Formatter itself because its Format method
+// takes a layout, so convert the value instead:
+//
+//	fmt.Printf("%+v", mildtg.DTG(t)) // 01120030R JAN 2024
+//
+// The verbs are:
+//
+//	%v, %s  date-time-group with a two-digit year, as Time.String
+//	%z      the same converted to Zulu
+//	%n      the same with the phonetic zone name, as in "011200 ROMEO JAN 24"
+//	%q      a double-quoted %s
+//	%#v     Go syntax, as Time.GoString
+//
+// The + flag writes a four-digit year. A precision of zero omits the
+// seconds and any other precision always writes them; without one the
+// seconds are written only when they are not zero. A width pads the
+// result with spaces on the left, or on the right with the - flag.
+type DTG Time
+
+// Format implements fmt.Formatter.
+func (d DTG) Format(f fmt.State, verb rune) {
+	t := Time(d)
+
+	var buf [64]byte
+	b := buf[:0]
+
+	switch verb {
+	case 'v', 's', 'q', 'z', 'n':
+		if verb == 'v' && f.Flag('#') {
+			b = append(b, t.GoString()...)
+			break
+		}
+
+		if verb == 'z' && !t.IsZero() {
+			t = NewTime(t.Time.In(ZULU.Location()))
+		}
+
+		seconds := "[ss]"
+		if prec, ok := f.Precision(); ok {
+			seconds = "ss"
+			if prec == 0 {
+				seconds = ""
+			}
+		}
+
+		zone := "Z "
+		if verb == 'n' {
+			zone = " ZONE "
+		}
+
+		year := "YY"
+		if f.Flag('+') {
+			year = "YYYY"
+		}
+
+		b = t.AppendFormat(b, "DDhhmm"+seconds+zone+"MMM "+year)
+		if verb == 'q' {
+			b = strconv.AppendQuote(make([]byte, 0, len(b)+2), string(b))
+		}
+	default:
+		fmt.Fprintf(f, "%%!%c(mildtg.DTG=%s)", verb, t.String())
+		return
+	}
+
+	width, ok := f.Width()
+	if !ok || width <= utf8.RuneCount(b) {
+		_, _ = f.Write(b)
+		return
+	}
+
+	pad := strings.Repeat(" ", width-utf8.RuneCount(b))
+	if f.Flag('-') {
+		_, _ = f.Write(b)
+		_, _ = io.WriteString(f, pad)
+		return
+	}
+
+	_, _ = io.WriteString(f, pad)
+	_, _ = f.Write(b)
+}
+
+// GoString implements fmt.GoStringer and formats t as Go syntax for %#v,
+// such as "mildtg.NewTime(time.Date(2024, time.January, 1, 12, 0, 0, 0,
+// mildtg.ROMEO.Location()))".
+func (t Time) GoString() string {
+	tz, ok := zoneOf(t.Time)
+	if !ok || tz.suffix != "" || t.Location() != tz.Location() {
+		return "mildtg.NewTime(" + t.Time.GoString() + ")"
+	}
+
+	year, month, day := t.Date()
+	hour, minute, seconds := t.Clock()
+
+	return fmt.Sprintf("mildtg.NewTime(time.Date(%d, time.%s, %d, %d, %d, %d, %d, mildtg.%s.Location()))",
+		year, month, day, hour, minute, seconds, t.Nanosecond(), tz.name)
+}
@@ -0,0 +1,397 @@
+package mildtg
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestFormatter_Format(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone database unavailable: %v", err)
+	}
+
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Skipf("time zone database unavailable: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		formatter Formatter
+		input     Time
+		layout    string
+		want      string
+		error     error
+	}{
+		{
+			name:   "utc is zulu",
+			input:  NewTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)),
+			layout: MILDTGSHORTYEAR,
+			want:   "011200Z JAN 24",
+		},
+		{
+			name:   "new york standard time is romeo",
+			input:  NewTime(time.Date(2024, 1, 1, 12, 0, 0, 0, newYork)),
+			layout: MILDTGSHORTYEAR,
+			want:   "011200R JAN 24",
+		},
+		{
+			name:   "new york daylight saving time is quebec",
+			input:  NewTime(time.Date(2024, 7, 1, 12, 0, 0, 0, newYork)),
+			layout: MILDTGFULLYEAR,
+			want:   "011200Q JUL 2024",
+		},
+		{
+			name:   "fixed zone without a letter name",
+			input:  NewTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.FixedZone("CET", 3600))),
+			layout: MILDTGSHORTYEAR,
+			want:   "011200A JAN 24",
+		},
+		{
+			name:   "letter name with the wrong offset",
+			input:  NewTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.FixedZone("Z", 3600))),
+			layout: MILDTGSHORTYEAR,
+			want:   "011200A JAN 24",
+		},
+		{
+			name:   "kolkata is echo asterisk",
+			input:  NewTime(time.Date(2024, 1, 1, 12, 0, 0, 0, kolkata)),
+			layout: MILDTGSHORTYEAR,
+			want:   "011200E* JAN 24",
+		},
+		{
+			name:   "line islands is mike double prime",
+			input:  NewTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.FixedZone("+14", 14*3600))),
+			layout: MILDTGFULLYEAR,
+			want:   "011200M'' JAN 2024",
+		},
+		{
+			name:   "no letter converts to zulu",
+			input:  NewTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.FixedZone("+0115", 4500))),
+			layout: MILDTGSHORTYEAR,
+			want:   "011045Z JAN 24",
+		},
+		{
+			name:      "no letter with error policy",
+			formatter: Formatter{ZonePolicy: ZonePolicyError},
+			input:     NewTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.FixedZone("+0115", 4500))),
+			layout:    MILDTGSHORTYEAR,
+			error:     ErrNoZoneLetter,
+		},
+		{
+			name:   "other layouts use time.Format",
+			input:  NewTime(time.Date(2024, 1, 1, 12, 0, 0, 0, newYork)),
+			layout: time.RFC3339,
+			want:   "2024-01-01T12:00:00-05:00",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.formatter.Format(tt.input, tt.layout)
+			if !errors.Is(err, tt.error) {
+				t.Fatalf("got %v, want %v", err, tt.error)
+			}
+
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTime_FormatNoZoneLetter(t *testing.T) {
+	t.Parallel()
+
+	// String uses the zero Formatter, which converts to Zulu
+	// rather than writing the location name.
+	in := NewTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.FixedZone("+1345", 49500)))
+
+	if got := in.String(); got != "312215Z DEC 23" {
+		t.Errorf("got %v, want %v", got, "312215Z DEC 23")
+	}
+}
+
+func TestFormatter_FormatJuliet(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone database unavailable: %v", err)
+	}
+
+	in, err := ParseDTGInLocation("010100J JUL 2024", newYork)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, err := Formatter{Juliet: newYork}.Format(in, MILDTGFULLYEAR)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got != "010100J JUL 2024" {
+		t.Errorf("got %v, want %v", got, "010100J JUL 2024")
+	}
+
+	// Without the Juliet location the letter is derived from the offset.
+	if got := in.Format(MILDTGFULLYEAR); got != "010100Q JUL 2024" {
+		t.Errorf("got %v, want %v", got, "010100Q JUL 2024")
+	}
+
+	// Times in time.Local are only written with J when asked to.
+	local := NewTime(time.Date(2024, 7, 1, 1, 0, 0, 0, time.Local))
+	if got, _ := (Formatter{Juliet: time.Local}).Format(local, MILDTGFULLYEAR); got != "010100J JUL 2024" {
+		t.Errorf("got %v, want %v", got, "010100J JUL 2024")
+	}
+
+	_, offset := local.Zone()
+	want := local.In(time.FixedZone("", offset)).Format(MILDTGFULLYEAR)
+	if got := local.Format(MILDTGFULLYEAR); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	b, err := local.MarshalText()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if string(b) != want {
+		t.Errorf("got %s, want %v", b, want)
+	}
+}
+
+func TestTime_MarshalLocalRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone database unavailable: %v", err)
+	}
+
+	// A time in time.Local read back on a host in another zone.
+	local := NewTime(time.Date(2024, 7, 1, 1, 0, 0, 0, time.Local))
+
+	b, err := local.MarshalJSON()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, err := ParseDTGInLocation(string(b[1:len(b)-1]), newYork)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !got.Equal(local.Time) {
+		t.Errorf("got %v, want %v", got.Time, local.Time)
+	}
+}
+
+func TestDTG_Format(t *testing.T) {
+	t.Parallel()
+
+	romeo := NewTime(time.Date(2024, 1, 1, 12, 0, 30, 0, ROMEO.Location()))
+	zulu := NewTime(time.Date(2024, 1, 1, 12, 0, 0, 0, ZULU.Location()))
+	kolkata := NewTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.FixedZone("IST", 19800)))
+
+	tests := []struct {
+		name   string
+		format string
+		input  Time
+		want   string
+	}{
+		{name: "v", format: "%v", input: zulu, want: "011200Z JAN 24"},
+		{name: "v full year", format: "%+v", input: zulu, want: "011200Z JAN 2024"},
+		{name: "s", format: "%s", input: romeo, want: "01120030R JAN 24"},
+		{name: "s full year", format: "%+s", input: romeo, want: "01120030R JAN 2024"},
+		{name: "zulu", format: "%z", input: romeo, want: "01170030Z JAN 24"},
+		{name: "zulu full year", format: "%+z", input: romeo, want: "01170030Z JAN 2024"},
+		{name: "zone name", format: "%n", input: romeo, want: "01120030 ROMEO JAN 24"},
+		{name: "extended zone name", format: "%+n", input: kolkata, want: "011200 ECHO* JAN 2024"},
+		{name: "quoted", format: "%q", input: zulu, want: `"011200Z JAN 24"`},
+		{name: "no seconds", format: "%.0v", input: romeo, want: "011200R JAN 24"},
+		{name: "seconds", format: "%.2v", input: zulu, want: "01120000Z JAN 24"},
+		{name: "width", format: "[%16v]", input: zulu, want: "[  011200Z JAN 24]"},
+		{name: "width left", format: "[%-16v]", input: zulu, want: "[011200Z JAN 24  ]"},
+		{name: "width shorter", format: "[%2v]", input: zulu, want: "[011200Z JAN 24]"},
+		{name: "width with suffix", format: "[%-16s]", input: kolkata, want: "[011200E* JAN 24 ]"},
+		{
+			name:   "go syntax",
+			format: "%#v",
+			input:  romeo,
+			want:   "mildtg.NewTime(time.Date(2024, time.January, 1, 12, 0, 30, 0, mildtg.ROMEO.Location()))",
+		},
+		{name: "zero", format: "%v", input: Time{}, want: invalidDTG},
+		{name: "zero zulu", format: "%z", input: Time{}, want: invalidDTG},
+		{name: "bad verb", format: "%d", input: zulu, want: "%!d(mildtg.DTG=011200Z JAN 24)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, DTG(tt.input)); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTime_GoString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input Time
+		want  string
+	}{
+		{
+			name:  "military zone",
+			input: NewTime(time.Date(2024, 1, 1, 12, 0, 0, 5, ZULU.Location())),
+			want:  "mildtg.NewTime(time.Date(2024, time.January, 1, 12, 0, 0, 5, mildtg.ZULU.Location()))",
+		},
+		{
+			name:  "utc",
+			input: NewTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)),
+			want:  "mildtg.NewTime(time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC))",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fmt.Sprintf("%#v", tt.input); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatter_FormatCenturyWindowRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ref := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	got, err := Parser{CenturyWindow: 50, Reference: ref}.Parse("010000Z JAN 75")
+	if err != nil {
+		t.Fatalf("got %v, want nil", err)
+	}
+
+	if got.Year() != 2075 {
+		t.Fatalf("got %v, want %v", got.Year(), 2075)
+	}
+
+	// The zero Formatter uses the fixed 1969 through 2068 window.
+	if s := got.String(); s != invalidDTG {
+		t.Errorf("got %v, want %v", s, invalidDTG)
+	}
+
+	s, err := Formatter{CenturyWindow: 50, Reference: ref}.Format(got, MILDTGSHORTYEAR)
+	if err != nil {
+		t.Fatalf("got %v, want nil", err)
+	}
+
+	if want := "010000Z JAN 75"; s != want {
+		t.Errorf("got %v, want %v", s, want)
+	}
+}
+
+func TestFormatter_FormatYearBounds(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		formatter Formatter
+		input     Time
+		layout    string
+		want      string
+		error     error
+	}{
+		{
+			name:   "short year in window",
+			input:  NewTime(time.Date(2068, 1, 1, 12, 0, 0, 0, ZULU.Location())),
+			layout: MILDTGSHORTYEAR,
+			want:   "011200Z JAN 68",
+		},
+		{
+			name:   "short year read back in another century",
+			input:  NewTime(time.Date(2069, 1, 1, 12, 0, 0, 0, ZULU.Location())),
+			layout: MILDTGSHORTYEAR,
+			error:  ErrYearOutOfRange,
+		},
+		{
+			name:      "short year in century window",
+			formatter: Formatter{CenturyWindow: 50, Reference: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)},
+			input:     NewTime(time.Date(2074, 1, 1, 12, 0, 0, 0, ZULU.Location())),
+			layout:    MILDTGSHORTYEAR,
+			want:      "011200Z JAN 74",
+		},
+		{
+			name:      "short year outside century window",
+			formatter: Formatter{CenturyWindow: 50, Reference: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)},
+			input:     NewTime(time.Date(1974, 1, 1, 12, 0, 0, 0, ZULU.Location())),
+			layout:    MILDTGSHORTYEAR,
+			error:     ErrYearOutOfRange,
+		},
+		{
+			name:      "century window out of range",
+			formatter: Formatter{CenturyWindow: 100},
+			input:     NewTime(time.Date(2024, 1, 1, 12, 0, 0, 0, ZULU.Location())),
+			layout:    MILDTGSHORTYEAR,
+			error:     ErrYearOutOfRange,
+		},
+		{
+			name:   "full year outside short window",
+			input:  NewTime(time.Date(1950, 1, 1, 12, 0, 0, 0, ZULU.Location())),
+			layout: MILDTGFULLYEAR,
+			want:   "011200Z JAN 1950",
+		},
+		{
+			name:   "short year after end of day",
+			input:  NewTime(time.Date(1969, 1, 1, 0, 0, 0, 0, ZULU.Location())),
+			layout: "DDkkmmZ MMM YY",
+			error:  ErrYearOutOfRange,
+		},
+		{
+			name:   "below default minimum",
+			input:  NewTime(time.Date(1940, 1, 1, 12, 0, 0, 0, ZULU.Location())),
+			layout: MILDTGFULLYEAR,
+			error:  ErrYearOutOfRange,
+		},
+		{
+			name:      "lower minimum",
+			formatter: Formatter{MinYear: 1900},
+			input:     NewTime(time.Date(1940, 1, 1, 12, 0, 0, 0, ZULU.Location())),
+			layout:    MILDTGFULLYEAR,
+			want:      "011200Z JAN 1940",
+		},
+		{
+			name:      "above maximum",
+			formatter: Formatter{MaxYear: 2030},
+			input:     NewTime(time.Date(2031, 1, 1, 12, 0, 0, 0, ZULU.Location())),
+			layout:    MILDTGFULLYEAR,
+			error:     ErrYearOutOfRange,
+		},
+		{
+			name:   "time layout is not bounded",
+			input:  NewTime(time.Date(1900, 1, 1, 12, 0, 0, 0, ZULU.Location())),
+			layout: "2006-01-02",
+			want:   "1900-01-01",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.formatter.Format(tt.input, tt.layout)
+			if !errors.Is(err, tt.error) {
+				t.Fatalf("got %v, want %v", err, tt.error)
+			}
+
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
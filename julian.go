@@ -0,0 +1,171 @@
+package mildtg
+
+import "time"
+
+// ParseJulian parses a military Julian date, which is an ordinal date
+// written as a year followed by the three-digit day of the year:
+//
+//	YDDD     "4015", the last digit of the year, such as January 15, 2024
+//	YYDDD    "24015", a two-digit year
+//	YYYYDDD  "2024015", a four-digit year
+//
+// A one-digit year is the year ending in that digit chosen from the
+// decades around the current time, as for a missing year in ParseDTG.
+// A two-digit year is placed in a century as for ParseDTG. The time is
+// midnight Zulu.
+//
+// ParseJulian is equivalent to Parser{}.ParseJulian(s).
+func ParseJulian(s string) (Time, error) {
+	return Parser{}.ParseJulian(s)
+}
+
+// ParseJulian parses a military Julian date as described for the
+// package-level ParseJulian, using the parser's defaults. The time is
+// midnight in the parser's default zone.
+func (p Parser) ParseJulian(s string) (Time, error) {
+	if err := p.validate(s); err != nil {
+		return Time{}, err
+	}
+
+	p = p.withReference()
+
+	width := len(s) - 3
+	if width != 1 && width != 2 && width != 4 {
+		return Time{}, newParseError(s, 0, ComponentNone, ErrInvalidDateTimeGroup,
+			"julian date %q must have four, five or seven digits", s)
+	}
+
+	y, _, err := layoutDigits(s, 0, width, ComponentYear)
+	if err != nil {
+		return Time{}, err
+	}
+
+	yday, _, err := layoutDigits(s, width, 3, ComponentDay)
+	if err != nil {
+		return Time{}, err
+	}
+
+	f := dtgFields{dayPos: width, hasMonth: true, hasYear: true}
+
+	f.year, f.month, f.day, err = p.julianDate(s, y, width, 0, yday, width, p.location(p.defaultZone()))
+	if err != nil {
+		return Time{}, err
+	}
+
+	return p.resolve(s, f)
+}
+
+// julianDate returns the date of day yday of the year y written with
+// width digits, reporting errors at yearPos and ydayPos in s. A one-digit
+// year is chosen with the parser's Bias from the decades around the
+// reference time, comparing midnight in loc.
+func (p Parser) julianDate(s string, y, width, yearPos, yday, ydayPos int,
+	loc *time.Location) (int, time.Month, int, error) {
+	year := y
+	switch width {
+	case 1:
+		var found, validDay bool
+		year, found, validDay = p.inferJulianYear(y, yday, loc)
+
+		switch {
+		case !validDay:
+			return 0, 0, 0, newParseError(s, ydayPos, ComponentDay, ErrInvalidDay,
+				"day \"%03d\" does not exist in any candidate year", yday)
+		case !found:
+			return 0, 0, 0, newParseError(s, yearPos, ComponentYear, ErrYearOutOfRange,
+				"no year ending in %d is within the year bounds", y)
+		}
+	case 2:
+		year = p.expandYear(y, p.reference().UTC().Year())
+	}
+
+	if yday < 1 || yday > daysInYear(year) {
+		return 0, 0, 0, newParseError(s, ydayPos, ComponentDay, ErrInvalidDay,
+			"day \"%03d\" out of range for %d", yday, year)
+	}
+
+	month, day := ordinalDate(year, yday)
+
+	return year, month, day, nil
+}
+
+// inferJulianYear returns the year ending in the digit y, chosen with
+// the parser's Bias from the decades around the reference time. The
+// found result reports whether a year was selected, and validDay whether
+// yday exists in any candidate.
+func (p Parser) inferJulianYear(y, yday int, loc *time.Location) (year int, found, validDay bool) {
+	ref := p.reference()
+	refYear := ref.In(loc).Year()
+
+	var best time.Time
+	for cy := refYear - refYear%10 + y - 10; cy <= refYear+10; cy += 10 {
+		if yday < 1 || yday > daysInYear(cy) {
+			continue
+		}
+
+		validDay = true
+
+		if !p.yearInRange(cy) {
+			continue
+		}
+
+		c := time.Date(cy, time.January, yday, 0, 0, 0, 0, loc)
+
+		switch p.Bias {
+		case BiasPast:
+			if c.After(ref) || found && !c.After(best) {
+				continue
+			}
+		case BiasFuture:
+			if c.Before(ref) || found && !c.Before(best) {
+				continue
+			}
+		default:
+			if found && absDuration(c.Sub(ref)) >= absDuration(best.Sub(ref)) {
+				continue
+			}
+		}
+
+		best, found = c, true
+	}
+
+	return best.Year(), found, validDay
+}
+
+// ordinalDate returns the month and day of day yday of year.
+func ordinalDate(year, yday int) (time.Month, int) {
+	m := time.January
+	for m < time.December && yday > daysInMonth(m, year) {
+		yday -= daysInMonth(m, year)
+		m++
+	}
+
+	return m, yday
+}
+
+// daysInYear returns the number of days in year.
+func daysInYear(year int) int {
+	days := 0
+	for m := time.January; m <= time.December; m++ {
+		days += daysInMonth(m, year)
+	}
+
+	return days
+}
+
+// JulianDate returns the military Julian date of t in its own time zone,
+// written YDDD as in document numbers, such as "4015" for January 15, 2024.
+// Use the layout tokens described for Time.Format for other widths.
+func (t Time) JulianDate() string {
+	return string(appendJulian(make([]byte, 0, 4), t.Year(), t.YearDay()))
+}
+
+// appendJulian appends the YDDD form of day yday of year to b.
+func appendJulian(b []byte, year, yday int) []byte {
+	y := year % 10
+	if y < 0 {
+		y = -y
+	}
+
+	return appendInt(appendInt(b, y, 1), yday, 3)
+}
@@ -0,0 +1,174 @@
+package mildtg
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParser_ParseJulian(t *testing.T) {
+	t.Parallel()
+
+	reference := time.Date(2024, 3, 10, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		parser Parser
+		input  string
+		want   time.Time
+		error  error
+	}{
+		{
+			name:   "one-digit year",
+			parser: Parser{Reference: reference},
+			input:  "4015",
+			want:   time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "one-digit year in the previous decade",
+			parser: Parser{Reference: reference},
+			input:  "9365",
+			want:   time.Date(2019, 12, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "one-digit year in the next decade",
+			parser: Parser{Reference: time.Date(2029, 12, 1, 0, 0, 0, 0, time.UTC)},
+			input:  "0010",
+			want:   time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "one-digit year with past bias",
+			parser: Parser{Reference: reference, Bias: BiasPast},
+			input:  "4100",
+			want:   time.Date(2014, 4, 10, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "leap day only in some decades",
+			parser: Parser{Reference: reference},
+			input:  "0366",
+			want:   time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "two-digit year",
+			parser: Parser{Reference: reference},
+			input:  "24060",
+			want:   time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "two-digit year in the previous century",
+			parser: Parser{Reference: reference},
+			input:  "99001",
+			want:   time.Date(1999, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "four-digit year",
+			parser: Parser{Reference: reference},
+			input:  "2023365",
+			want:   time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "default zone",
+			parser: Parser{Reference: reference, DefaultZone: ROMEO},
+			input:  "2024001",
+			want:   time.Date(2024, 1, 1, 5, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "day 366 in a common year",
+			parser: Parser{Reference: reference},
+			input:  "2023366",
+			error:  ErrInvalidDay,
+		},
+		{
+			name:   "day zero",
+			parser: Parser{Reference: reference},
+			input:  "4000",
+			error:  ErrInvalidDay,
+		},
+		{
+			name:   "no leap year in any decade",
+			parser: Parser{Reference: reference},
+			input:  "3366",
+			error:  ErrInvalidDay,
+		},
+		{
+			name:   "year out of range",
+			parser: Parser{Reference: reference},
+			input:  "1900001",
+			error:  ErrYearOutOfRange,
+		},
+		{
+			name:   "six digits",
+			parser: Parser{Reference: reference},
+			input:  "240150",
+			error:  ErrInvalidDateTimeGroup,
+		},
+		{
+			name:   "not digits",
+			parser: Parser{Reference: reference},
+			input:  "4O15",
+			error:  ErrInvalidDateTimeGroup,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parser.ParseJulian(tt.input)
+			if !errors.Is(err, tt.error) {
+				t.Fatalf("got %v, want %v", err, tt.error)
+			}
+
+			if err == nil && !got.Equal(tt.want) {
+				t.Errorf("got %v, want %v", got.Time, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseJulian(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseJulian("2024015")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got.Format(MILDTGFULLYEAR) != "150000Z JAN 2024" {
+		t.Errorf("got %v, want %v", got.Format(MILDTGFULLYEAR), "150000Z JAN 2024")
+	}
+}
+
+func TestTime_JulianDate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input Time
+		want  string
+	}{
+		{input: NewTime(time.Date(2024, 1, 15, 12, 0, 0, 0, ZULU.Location())), want: "4015"},
+		{input: NewTime(time.Date(2020, 12, 31, 23, 59, 0, 0, ZULU.Location())), want: "0366"},
+		{input: NewTime(time.Date(2019, 3, 1, 0, 0, 0, 0, ROMEO.Location())), want: "9060"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.input.JulianDate(); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrdinalDate(t *testing.T) {
+	t.Parallel()
+
+	for _, year := range []int{2023, 2024, 1900, 2000} {
+		for yday := 1; yday <= daysInYear(year); yday++ {
+			month, day := ordinalDate(year, yday)
+			want := time.Date(year, time.January, yday, 0, 0, 0, 0, time.UTC)
+
+			if month != want.Month() || day != want.Day() {
+				t.Fatalf("%d day %d: got %v %d, want %v %d", year, yday, month, day, want.Month(), want.Day())
+			}
+		}
+	}
+}
@@ -0,0 +1,562 @@
+package mildtg
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// The token layouts equivalent to MILDTGFULLYEAR and MILDTGSHORTYEAR.
+// The tokens are described for Time.Format.
+const (
+	layoutFullYear  = "DDhhmm[ss]Z MMM YYYY"
+	layoutShortYear = "DDhhmm[ss]Z MMM YY"
+)
+
+// Layout tokens.
+const (
+	tokenNone = iota
+	tokenDay
+	tokenDayOfYear
+	tokenHour
+	tokenEndOfDayHour
+	tokenMinute
+	tokenSeconds
+	tokenOptionalSeconds
+	tokenZone
+	tokenZoneName
+	tokenMonth
+	tokenMonthName
+	tokenYear
+	tokenShortYear
+	tokenYearDigit
+	tokenQuoted
+)
+
+// dtgLayout returns the token layout for layout and reports whether
+// layout is a date-time-group layout rather than a time.Time layout.
+// A layout is a date-time-group layout if it is one of the MILDTG
+// constants or uses any of the DD, hh, kk, MMM or YY tokens outside
+// quoted text, none of which have a meaning in time.Time layouts.
+func dtgLayout(layout string) (string, bool) {
+	switch layout {
+	case MILDTGFULLYEAR:
+		return layoutFullYear, true
+	case MILDTGSHORTYEAR:
+		return layoutShortYear, true
+	}
+
+	for rest := layout; rest != ""; {
+		_, token, suffix := nextToken(rest)
+		switch token {
+		case tokenDay, tokenDayOfYear, tokenHour, tokenEndOfDayHour,
+			tokenMonth, tokenMonthName, tokenYear, tokenShortYear:
+			return layout, true
+		}
+
+		rest = suffix
+	}
+
+	return layout, false
+}
+
+// nextToken returns the literal text before the first token in layout,
+// the token, and the text after the token. Text in single quotes is
+// returned as the prefix of tokenQuoted, still holding any doubled
+// quotes; use unquote to read it.
+func nextToken(layout string) (prefix string, token int, suffix string) {
+	for i := 0; i < len(layout); i++ {
+		rest := layout[i:]
+
+		switch layout[i] {
+		case '\'':
+			if i > 0 {
+				return layout[:i], tokenNone, rest
+			}
+
+			// Two single quotes outside quoted text write one.
+			if strings.HasPrefix(rest, "''") {
+				return layout[:1], tokenNone, layout[2:]
+			}
+
+			// The quoted text runs to the next single quote that is not
+			// doubled, or to the end of the layout.
+			for j := 1; j < len(layout); j++ {
+				if layout[j] != '\'' {
+					continue
+				}
+
+				if j+1 < len(layout) && layout[j+1] == '\'' {
+					j++
+					continue
+				}
+
+				return layout[1:j], tokenQuoted, layout[j+1:]
+			}
+
+			return layout[1:], tokenQuoted, ""
+		case 'D':
+			if strings.HasPrefix(rest, "DDD") {
+				return layout[:i], tokenDayOfYear, layout[i+3:]
+			}
+
+			if strings.HasPrefix(rest, "DD") {
+				return layout[:i], tokenDay, layout[i+2:]
+			}
+		case 'h':
+			if strings.HasPrefix(rest, "hh") {
+				return layout[:i], tokenHour, layout[i+2:]
+			}
+		case 'k':
+			if strings.HasPrefix(rest, "kk") {
+				return layout[:i], tokenEndOfDayHour, layout[i+2:]
+			}
+		case 'm':
+			if strings.HasPrefix(rest, "mm") {
+				return layout[:i], tokenMinute, layout[i+2:]
+			}
+		case 's':
+			if strings.HasPrefix(rest, "ss") {
+				return layout[:i], tokenSeconds, layout[i+2:]
+			}
+		case '[':
+			if strings.HasPrefix(rest, "[ss]") {
+				return layout[:i], tokenOptionalSeconds, layout[i+4:]
+			}
+		case 'Z':
+			if strings.HasPrefix(rest, "ZONE") {
+				return layout[:i], tokenZoneName, layout[i+4:]
+			}
+
+			return layout[:i], tokenZone, layout[i+1:]
+		case 'M':
+			if strings.HasPrefix(rest, "MMMM") {
+				return layout[:i], tokenMonthName, layout[i+4:]
+			}
+
+			if strings.HasPrefix(rest, "MMM") {
+				return layout[:i], tokenMonth, layout[i+3:]
+			}
+		case 'Y':
+			if strings.HasPrefix(rest, "YYYY") {
+				return layout[:i], tokenYear, layout[i+4:]
+			}
+
+			if strings.HasPrefix(rest, "YY") {
+				return layout[:i], tokenShortYear, layout[i+2:]
+			}
+
+			// A one-digit year is only a token in the Julian form YDDD.
+			if strings.HasPrefix(rest, "YDDD") {
+				return layout[:i], tokenYearDigit, layout[i+1:]
+			}
+		}
+	}
+
+	return layout, tokenNone, ""
+}
+
+// unquote returns the literal text of the prefix returned by nextToken
+// for token, replacing doubled quotes in quoted text with one.
+func unquote(prefix string, token int) string {
+	if token != tokenQuoted {
+		return prefix
+	}
+
+	return strings.ReplaceAll(prefix, "''", "'")
+}
+
+// appendLayout appends t formatted according to the token layout to b,
+// writing tz as its time zone. It fails if the year written is outside
+// the formatter's bounds or cannot be read back from a two-digit year.
+func (f Formatter) appendLayout(b []byte, t time.Time, tz TimeZone, layout string) ([]byte, error) {
+	// The kk token writes midnight as 2400 at the end of the previous day.
+	hour, minute, seconds := t.Clock()
+	endOfDay := hour == 0 && minute == 0 && seconds == 0 && t.Nanosecond() == 0 &&
+		hasToken(layout, tokenEndOfDayHour)
+
+	return f.appendFields(b, t, tz, layout, endOfDay)
+}
+
+// appendFields is like appendLayout, but writes t as 2400 at the end of
+// the previous day with both hh and kk if endOfDay is set, and as is
+// otherwise. t must be midnight if endOfDay is set.
+func (f Formatter) appendFields(b []byte, t time.Time, tz TimeZone, layout string, endOfDay bool) ([]byte, error) {
+	year, month, day := t.Date()
+	hour, minute, seconds := t.Clock()
+
+	if endOfDay {
+		year, month, day = time.Date(year, month, day-1, 0, 0, 0, 0, time.UTC).Date()
+	}
+
+	if lo, hi := yearBounds(f.MinYear, f.MaxYear); year < lo || year > hi {
+		return b, fmt.Errorf("%w: %d is not between %d and %d", ErrYearOutOfRange, year, lo, hi)
+	}
+
+	// A two-digit year must read back as the same year.
+	if hasToken(layout, tokenShortYear) && !f.isShortYear(year) {
+		return b, fmt.Errorf("%w: %d is ambiguous as a two-digit year", ErrYearOutOfRange, year)
+	}
+
+	for layout != "" {
+		prefix, token, suffix := nextToken(layout)
+		b = append(b, unquote(prefix, token)...)
+		layout = suffix
+
+		switch token {
+		case tokenDay:
+			b = appendInt(b, day, 2)
+		case tokenDayOfYear:
+			b = appendInt(b, time.Date(year, month, day, 0, 0, 0, 0, time.UTC).YearDay(), 3)
+		case tokenHour, tokenEndOfDayHour:
+			if endOfDay {
+				b = appendInt(b, 24, 2)
+			} else {
+				b = appendInt(b, hour, 2)
+			}
+		case tokenMinute:
+			b = appendInt(b, minute, 2)
+		case tokenSeconds:
+			b = appendInt(b, seconds, 2)
+		case tokenOptionalSeconds:
+			// Only export seconds if they are not zero
+			if seconds != 0 {
+				b = appendInt(b, seconds, 2)
+			}
+		case tokenZone:
+			b = append(b, byte(tz.letter))
+			b = append(b, tz.suffix...)
+		case tokenZoneName:
+			b = append(b, tz.name...)
+		case tokenMonth:
+			b = append(b, monthNames[month][:3]...)
+		case tokenMonthName:
+			b = append(b, monthNames[month]...)
+		case tokenYear:
+			b = appendInt(b, year, 4)
+		case tokenShortYear:
+			b = appendInt(b, year%100, 2)
+		case tokenYearDigit:
+			b = appendInt(b, year%10, 1)
+		}
+	}
+
+	return b, nil
+}
+
+// hasToken reports whether the token layout contains token.
+func hasToken(layout string, token int) bool {
+	for layout != "" {
+		_, t, suffix := nextToken(layout)
+		if t == token {
+			return true
+		}
+
+		layout = suffix
+	}
+
+	return false
+}
+
+// appendInt appends the decimal form of x to b, padded with leading
+// zeros to width digits.
+func appendInt(b []byte, x, width int) []byte {
+	if x < 0 {
+		b = append(b, '-')
+		x = -x
+	}
+
+	var buf [20]byte
+	i := len(buf)
+	for x >= 10 || width > 1 {
+		i--
+		buf[i] = byte('0' + x%10)
+		x /= 10
+		width--
+	}
+
+	i--
+	buf[i] = byte('0' + x)
+
+	return append(b, buf[i:]...)
+}
+
+// ParseLayout parses a date-time-group that exactly matches layout, such
+// as MILDTGFULLYEAR or "DDhhmmZ MMM YY", and returns the Time it represents.
+// Unlike ParseDTG it never guesses which digits are which, so "0112002024"
+// parses with the layout "DDhhmmYYYY". Literal text, including text in
+// single quotes, must match exactly, while month names and zone letters
+// may be in either case. A layout without a zone, month or year token
+// leaves that value to the defaults of ParseDTG, and one without hours or
+// minutes sets them to zero. A day of the year (DDD) is read with its
+// year as for ParseJulian. Layouts that are not date-time-group layouts
+// are parsed with time.Parse. Failures are reported as a *ParseError.
+//
+// ParseLayout is equivalent to Parser{}.ParseLayout(layout, s).
+func ParseLayout(layout, s string) (Time, error) {
+	return Parser{}.ParseLayout(layout, s)
+}
+
+// ParseLayoutInZone is like ParseLayout but uses tz when the layout or
+// the input does not give a time zone.
+//
+// ParseLayoutInZone is equivalent to Parser{DefaultZone: tz}.ParseLayout(layout, s).
+func ParseLayoutInZone(layout, s string, tz TimeZone) (Time, error) {
+	return Parser{DefaultZone: tz}.ParseLayout(layout, s)
+}
+
+// ParseLayout parses s according to layout as described for the
+// package-level ParseLayout, using the parser's defaults.
+func (p Parser) ParseLayout(layout, s string) (Time, error) {
+	if err := p.validate(s); err != nil {
+		return Time{}, err
+	}
+
+	layout, ok := dtgLayout(layout)
+	if !ok {
+		t, err := time.ParseInLocation(layout, s, p.location(p.defaultZone()))
+		if err != nil {
+			return Time{}, newParseError(s, 0, ComponentNone, ErrInvalidDateTimeGroup, "%v", err)
+		}
+
+		return p.checkYear(s, t)
+	}
+
+	p = p.withReference()
+
+	var f dtgFields
+	var hasDay, hasYearDay bool
+
+	// A day of the year is resolved to a month and day once the year is
+	// known, and a one-digit year is resolved along with it.
+	var yday, ydayPos, yearDigit, yearWidth int
+
+	i := 0
+	for layout != "" {
+		prefix, token, suffix := nextToken(layout)
+		prefix = unquote(prefix, token)
+		layout = suffix
+
+		if !strings.HasPrefix(s[i:], prefix) {
+			if len(s)-i < len(prefix) && strings.HasPrefix(prefix, s[i:]) {
+				return Time{}, newParseError(s, len(s), ComponentNone, ErrNotEnoughChars,
+					"expected %q", prefix)
+			}
+
+			return Time{}, newParseError(s, i, ComponentNone, ErrInvalidDateTimeGroup,
+				"expected %q", prefix)
+		}
+
+		i += len(prefix)
+
+		var err error
+		width := 2
+		switch token {
+		case tokenDay:
+			f.day, f.dayPos, err = layoutDigits(s, i, 2, ComponentDay)
+			hasDay = true
+		case tokenDayOfYear:
+			yday, ydayPos, err = layoutDigits(s, i, 3, ComponentDay)
+			hasYearDay, width = true, 3
+		case tokenHour, tokenEndOfDayHour:
+			f.hour, f.hourPos, err = layoutDigits(s, i, 2, ComponentHour)
+		case tokenMinute:
+			f.minute, f.minutePos, err = layoutDigits(s, i, 2, ComponentMinute)
+		case tokenSeconds:
+			f.seconds, f.secondsPos, err = layoutDigits(s, i, 2, ComponentSeconds)
+			f.hasSeconds = true
+		case tokenOptionalSeconds:
+			if i+1 < len(s) && isDigit(s[i]) && isDigit(s[i+1]) {
+				f.seconds, f.secondsPos, err = layoutDigits(s, i, 2, ComponentSeconds)
+				f.hasSeconds = true
+			} else {
+				continue
+			}
+		case tokenZone:
+			f.zonePos = i
+			n := layoutDesignatorLen(s, i)
+			if n == 0 {
+				return Time{}, layoutMissing(s, i, ComponentZone)
+			}
+
+			if n == 1 {
+				f.tz, ok = zoneByLetterOrJuliet(rune(s[i]))
+			} else {
+				f.tz, ok = zoneByDesignator(rune(s[i]), s[i+1:i+n])
+			}
+
+			if !ok {
+				return Time{}, newParseError(s, i, ComponentZone, ErrInvalidDateTimeGroup,
+					"unknown time zone %q", s[i:i+n])
+			}
+
+			f.hasZone = true
+			i += n
+			continue
+		case tokenZoneName:
+			f.zonePos = i
+			n := layoutWordLen(s, i)
+			if n == 0 {
+				return Time{}, layoutMissing(s, i, ComponentZone)
+			}
+
+			n += layoutDesignatorLen(s, i+n-1) - 1
+			if strings.EqualFold(s[i:i+n], JULIET.name) {
+				f.tz, ok = JULIET, true
+			} else {
+				f.tz, ok = ZoneByName(s[i : i+n])
+			}
+
+			if !ok {
+				return Time{}, newParseError(s, i, ComponentZone, ErrInvalidDateTimeGroup,
+					"unknown time zone %q", s[i:i+n])
+			}
+
+			f.hasZone = true
+			i += n
+			continue
+		case tokenMonth, tokenMonthName:
+			n := layoutWordLen(s, i)
+			if token == tokenMonth && n > 3 {
+				n = 3
+			}
+
+			if n == 0 {
+				return Time{}, layoutMissing(s, i, ComponentMonth)
+			}
+
+			m, ok := months[strings.ToUpper(s[i:i+n])]
+			if !ok || token == tokenMonth && n != 3 || token == tokenMonthName && n != len(monthNames[m]) {
+				return Time{}, newParseError(s, i, ComponentMonth, ErrInvalidMonth,
+					"unknown month %q", s[i:i+n])
+			}
+
+			f.month, f.hasMonth = m, true
+			i += n
+			continue
+		case tokenYear:
+			f.year, f.yearPos, err = layoutDigits(s, i, 4, ComponentYear)
+			f.hasYear, yearWidth = true, 4
+			width = 4
+		case tokenShortYear:
+			var y int
+			y, f.yearPos, err = layoutDigits(s, i, 2, ComponentYear)
+			f.year, f.hasYear, yearWidth = p.expandYear(y, p.reference().UTC().Year()), true, 2
+		case tokenYearDigit:
+			yearDigit, f.yearPos, err = layoutDigits(s, i, 1, ComponentYear)
+			yearWidth = 1
+			width = 1
+		default:
+			continue
+		}
+
+		if err != nil {
+			return Time{}, err
+		}
+
+		i += width
+	}
+
+	if i < len(s) {
+		return Time{}, newParseError(s, i, ComponentNone, ErrInvalidDateTimeGroup,
+			"extra text %q", s[i:])
+	}
+
+	if hasYearDay {
+		tz := f.tz
+		if !f.hasZone {
+			tz = p.defaultZone()
+		}
+
+		// Two- and four-digit years are already expanded.
+		y, width := f.year, 4
+		switch yearWidth {
+		case 0:
+			return Time{}, newParseError(s, ydayPos, ComponentYear, ErrInvalidDateTimeGroup,
+				"layout has a day of the year but no year")
+		case 1:
+			y, width = yearDigit, 1
+		}
+
+		var err error
+		f.year, f.month, f.day, err = p.julianDate(s, y, width, f.yearPos, yday, ydayPos, p.location(tz))
+		if err != nil {
+			return Time{}, err
+		}
+
+		f.dayPos, f.hasMonth, f.hasYear, hasDay = ydayPos, true, true, true
+	}
+
+	if !hasDay {
+		return Time{}, newParseError(s, 0, ComponentDay, ErrInvalidDateTimeGroup,
+			"layout has no day")
+	}
+
+	return p.resolve(s, f)
+}
+
+// layoutDigits returns the value of the n digits for component c at
+// offset i in s, along with the offset.
+func layoutDigits(s string, i, n int, c Component) (int, int, error) {
+	v, ok := strictDigits(s, i, n)
+	if !ok {
+		if i+n > len(s) {
+			return 0, i, newParseError(s, len(s), c, ErrNotEnoughChars,
+				"expected %d-digit %s", n, c)
+		}
+
+		return 0, i, newParseError(s, i, c, ErrInvalidDateTimeGroup,
+			"expected %d-digit %s", n, c)
+	}
+
+	return v, i, nil
+}
+
+// layoutDesignatorLen returns the length of the zone letter at offset i
+// in s and its designation suffix, if any, or zero if there is no letter.
+func layoutDesignatorLen(s string, i int) int {
+	if i >= len(s) || !isLetter(s[i]) {
+		return 0
+	}
+
+	rest := s[i+1:]
+	switch {
+	case strings.HasPrefix(rest, suffixDoublePrime):
+		return 1 + len(suffixDoublePrime)
+	case strings.HasPrefix(rest, suffixPrime):
+		return 1 + len(suffixPrime)
+	case strings.HasPrefix(rest, suffixHalfHour):
+		return 1 + len(suffixHalfHour)
+	case strings.HasPrefix(rest, suffixThreeQuarterHour):
+		return 1 + len(suffixThreeQuarterHour)
+	}
+
+	return 1
+}
+
+// layoutWordLen returns the number of ASCII letters at offset i in s.
+func layoutWordLen(s string, i int) int {
+	n := 0
+	for i+n < len(s) && isLetter(s[i+n]) {
+		n++
+	}
+
+	return n
+}
+
+// layoutMissing returns the error for a missing component c at offset i in s.
+func layoutMissing(s string, i int, c Component) error {
+	if i >= len(s) {
+		return newParseError(s, i, c, ErrNotEnoughChars, "missing %s", c)
+	}
+
+	r, _ := utf8.DecodeRuneInString(s[i:])
+
+	return newParseError(s, i, c, ErrInvalidDateTimeGroup, "unexpected character %q for %s", r, c)
+}
+
+// isLetter reports whether c is an ASCII letter.
+func isLetter(c byte) bool {
+	return c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z'
+}
@@ -0,0 +1,438 @@
+package mildtg
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTime_FormatLayout(t *testing.T) {
+	t.Parallel()
+
+	noSeconds := NewTime(time.Date(2024, 1, 1, 12, 0, 0, 0, ZULU.Location()))
+	seconds := NewTime(time.Date(2005, 9, 30, 7, 5, 9, 0, ROMEO.Location()))
+	midnight := NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, ZULU.Location()))
+	issued := NewTime(time.Date(2024, 1, 1, 12, 0, 5, 0, ROMEO.Location()))
+
+	tests := []struct {
+		name   string
+		input  Time
+		layout string
+		want   string
+	}{
+		{name: "short year", input: noSeconds, layout: "DDhhmmZ MMM YY", want: "011200Z JAN 24"},
+		{name: "no separators", input: noSeconds, layout: "DDhhmmZMMMYYYY", want: "011200ZJAN2024"},
+		{name: "full month", input: noSeconds, layout: "DD hhmmZ MMMM YYYY", want: "01 1200Z JANUARY 2024"},
+		{name: "zone name", input: seconds, layout: "DDhhmm ZONE MMM YYYY", want: "300705 ROMEO SEP 2005"},
+		{name: "seconds", input: noSeconds, layout: "DDhhmmssZ", want: "01120000Z"},
+		{name: "optional seconds omitted", input: noSeconds, layout: "DDhhmm[ss]Z", want: "011200Z"},
+		{name: "optional seconds written", input: seconds, layout: "DDhhmm[ss]Z", want: "30070509R"},
+		{name: "padded short year", input: seconds, layout: MILDTGSHORTYEAR, want: "30070509R SEP 05"},
+		{name: "full year constant", input: seconds, layout: MILDTGFULLYEAR, want: "30070509R SEP 2005"},
+		{name: "separators", input: seconds, layout: "YYYY-MMM-DD hh:mm:ss Z", want: "2005-SEP-30 07:05:09 R"},
+		{name: "time layout", input: seconds, layout: "2006-01-02 15:04", want: "2005-09-30 07:05"},
+		{name: "end of day", input: midnight, layout: "NLT DDkkmmZ MMM YY", want: "NLT 312400Z DEC 24"},
+		{name: "julian", input: seconds, layout: "YDDD", want: "5273"},
+		{name: "literal Y", input: noSeconds, layout: "DAY DD MMM YYYY", want: "DAY 01 JAN 2024"},
+		{name: "literal Y before DD", input: noSeconds, layout: "YDD", want: "Y01"},
+		{name: "julian two-digit year", input: seconds, layout: "YYDDD", want: "05273"},
+		{name: "julian four-digit year", input: seconds, layout: "YYYYDDD hhmmZ", want: "2005273 0705R"},
+		{name: "end of day not midnight", input: noSeconds, layout: "DDkkmmZ MMM YY", want: "011200Z JAN 24"},
+		{name: "quoted kk", input: midnight, layout: "'kk' DDhhmmZ MMM YY", want: "kk 010000Z JAN 25"},
+		{name: "midnight with hh", input: midnight, layout: "DDhhmmZ MMM YY", want: "010000Z JAN 25"},
+		{name: "zero time", input: Time{}, layout: "DDhhmmZ MMM YY", want: invalidDTG},
+		{name: "quoted word with ss", input: issued, layout: "'Issued' DDhhmmZ MMM YY", want: "Issued 011200R JAN 24"},
+		{name: "quoted word with Z", input: issued, layout: "DDhhmmZ MMM YY '(ZULU)'", want: "011200R JAN 24 (ZULU)"},
+		{name: "quoted words with Y, mm and DD", input: issued, layout: "'DAY' DD, 'YEAR' YYYY 'COMMANDS'", want: "DAY 01, YEAR 2024 COMMANDS"},
+		{name: "quoted tokens", input: issued, layout: "'DDhhmmZ' DDhhmmZ", want: "DDhhmmZ 011200R"},
+		{name: "doubled quote", input: issued, layout: "hh''mm", want: "12'00"},
+		{name: "doubled quote in quoted text", input: issued, layout: "hhmm 'O''CLOCK' Z", want: "1200 O'CLOCK R"},
+		{name: "unterminated quote", input: issued, layout: "DD 'ZONE", want: "01 ZONE"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.Format(tt.layout); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNextToken(t *testing.T) {
+	t.Parallel()
+
+	var tokens []int
+	var literals []string
+
+	layout := "DD hhmm[ss]Z/ZONE MMMM-MMM YYYY/YYkkYDDD'DD''hh'"
+	for layout != "" {
+		prefix, token, suffix := nextToken(layout)
+		literals = append(literals, prefix)
+		tokens = append(tokens, token)
+		layout = suffix
+	}
+
+	wantTokens := []int{
+		tokenDay, tokenHour, tokenMinute, tokenOptionalSeconds, tokenZone, tokenZoneName,
+		tokenMonthName, tokenMonth, tokenYear, tokenShortYear, tokenEndOfDayHour,
+		tokenYearDigit, tokenDayOfYear, tokenQuoted,
+	}
+	wantLiterals := []string{"", " ", "", "", "", "/", " ", "-", " ", "/", "", "", "", "DD''hh"}
+
+	if len(tokens) != len(wantTokens) {
+		t.Fatalf("got %v, want %v", tokens, wantTokens)
+	}
+
+	for i := range tokens {
+		if tokens[i] != wantTokens[i] || literals[i] != wantLiterals[i] {
+			t.Errorf("token %d: got %q %v, want %q %v", i, literals[i], tokens[i], wantLiterals[i], wantTokens[i])
+		}
+	}
+}
+
+func TestAppendInt(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		x, width int
+		want     string
+	}{
+		{x: 0, width: 2, want: "00"},
+		{x: 5, width: 2, want: "05"},
+		{x: 59, width: 2, want: "59"},
+		{x: 24, width: 4, want: "0024"},
+		{x: 2024, width: 4, want: "2024"},
+		{x: 12345, width: 4, want: "12345"},
+		{x: -5, width: 2, want: "-05"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := string(appendInt(nil, tt.x, tt.width)); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseLayout(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		layout string
+		input  string
+		want   time.Time
+		error  error
+	}{
+		{
+			name:   "full year constant",
+			layout: MILDTGFULLYEAR,
+			input:  "011200Z JAN 2024",
+			want:   time.Date(2024, 1, 1, 12, 0, 0, 0, ZULU.Location()),
+		},
+		{
+			name:   "short year constant with seconds",
+			layout: MILDTGSHORTYEAR,
+			input:  "30070509R SEP 05",
+			want:   time.Date(2005, 9, 30, 7, 5, 9, 0, ROMEO.Location()),
+		},
+		{
+			name:   "no separators",
+			layout: "DDhhmmMMMYYYY",
+			input:  "011200JAN2024",
+			want:   time.Date(2024, 1, 1, 12, 0, 0, 0, ZULU.Location()),
+		},
+		{
+			name:   "lower case month and zone",
+			layout: "DDhhmmZ MMM YY",
+			input:  "011200r jan 24",
+			want:   time.Date(2024, 1, 1, 12, 0, 0, 0, ROMEO.Location()),
+		},
+		{
+			name:   "zone name and full month",
+			layout: "DD hhmm ZONE MMMM YYYY",
+			input:  "01 1200 CHARLIE* JANUARY 2024",
+			want:   time.Date(2024, 1, 1, 12, 0, 0, 0, time.FixedZone("C*", 3*3600+1800)),
+		},
+		{
+			name:   "extended zone letter",
+			layout: "DDhhmmZ MMM YYYY",
+			input:  "011200E† JAN 2024",
+			want:   time.Date(2024, 1, 1, 12, 0, 0, 0, time.FixedZone("E†", 5*3600+2700)),
+		},
+		{
+			name:   "separators",
+			layout: "YYYY-MMM-DD hh:mm:ss Z",
+			input:  "2005-SEP-30 07:05:09 R",
+			want:   time.Date(2005, 9, 30, 7, 5, 9, 0, ROMEO.Location()),
+		},
+		{
+			name:   "end of day",
+			layout: "NLT DDkkmmZ MMM YY",
+			input:  "NLT 312400Z DEC 24",
+			want:   time.Date(2025, 1, 1, 0, 0, 0, 0, ZULU.Location()),
+		},
+		{
+			name:   "julian",
+			layout: "YYYYDDD hhmmZ",
+			input:  "2024060 1200R",
+			want:   time.Date(2024, 2, 29, 12, 0, 0, 0, ROMEO.Location()),
+		},
+		{
+			name:   "julian without time",
+			layout: "YYDDD",
+			input:  "24366",
+			want:   time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "julian day out of range",
+			layout: "YYYYDDD",
+			input:  "2023366",
+			error:  ErrInvalidDay,
+		},
+		{
+			name:   "day of the year without a year",
+			layout: "DDD hhmm",
+			input:  "060 1200",
+			error:  ErrInvalidDateTimeGroup,
+		},
+		{
+			name:   "literal Y",
+			layout: "DAY DDhhmmZ MMM YY",
+			input:  "DAY 011200Z JAN 24",
+			want:   time.Date(2024, 1, 1, 12, 0, 0, 0, ZULU.Location()),
+		},
+		{
+			name:   "literal Y is not a year digit",
+			layout: "DDhhmm Y",
+			input:  "011200 4",
+			error:  ErrInvalidDateTimeGroup,
+		},
+		{
+			name:   "quoted word with ss",
+			layout: "'Issued' DDhhmmZ MMM YY",
+			input:  "Issued 011200Z JAN 24",
+			want:   time.Date(2024, 1, 1, 12, 0, 0, 0, ZULU.Location()),
+		},
+		{
+			name:   "quoted words with Z, Y, mm and DD",
+			layout: "'DAY' DD 'MMM' MMM 'YEAR' YY hhmm Z '(ZULU)'",
+			input:  "DAY 01 MMM JAN YEAR 24 1200 R (ZULU)",
+			want:   time.Date(2024, 1, 1, 12, 0, 0, 0, ROMEO.Location()),
+		},
+		{
+			name:   "doubled quote",
+			layout: "DDhhmm 'O''CLOCK' Z MMM YY",
+			input:  "011200 O'CLOCK Z JAN 24",
+			want:   time.Date(2024, 1, 1, 12, 0, 0, 0, ZULU.Location()),
+		},
+		{
+			name:   "quoted word mismatch",
+			layout: "'Issued' DDhhmmZ MMM YY",
+			input:  "Filed 011200Z JAN 24",
+			error:  ErrInvalidDateTimeGroup,
+		},
+		{
+			name:   "time layout with quoted tokens",
+			layout: "'DD' 2006-01-02",
+			input:  "'DD' 2024-01-01",
+			want:   time.Date(2024, 1, 1, 0, 0, 0, 0, ZULU.Location()),
+		},
+		{
+			name:   "time layout",
+			layout: "2006-01-02 15:04",
+			input:  "2024-01-01 12:00",
+			want:   time.Date(2024, 1, 1, 12, 0, 0, 0, ZULU.Location()),
+		},
+		{
+			name:   "missing zone",
+			layout: MILDTGFULLYEAR,
+			input:  "011200 JAN 2024",
+			error:  ErrInvalidDateTimeGroup,
+		},
+		{
+			name:   "seconds required",
+			layout: "DDhhmmssZ MMM YYYY",
+			input:  "011200Z JAN 2024",
+			error:  ErrInvalidDateTimeGroup,
+		},
+		{
+			name:   "full month for abbreviation",
+			layout: "DDhhmmZ MMM YYYY",
+			input:  "011200Z JANUARY 2024",
+			error:  ErrInvalidDateTimeGroup,
+		},
+		{
+			name:   "abbreviation for full month",
+			layout: "DDhhmmZ MMMM YYYY",
+			input:  "011200Z JAN 2024",
+			error:  ErrInvalidMonth,
+		},
+		{
+			name:   "extra text",
+			layout: "DDhhmmZ MMM YY",
+			input:  "011200Z JAN 2024",
+			error:  ErrInvalidDateTimeGroup,
+		},
+		{
+			name:   "truncated",
+			layout: MILDTGFULLYEAR,
+			input:  "011200Z JAN 20",
+			error:  ErrNotEnoughChars,
+		},
+		{
+			name:   "day out of range",
+			layout: MILDTGFULLYEAR,
+			input:  "301200Z FEB 2024",
+			error:  ErrInvalidDay,
+		},
+		{
+			name:   "year out of range",
+			layout: MILDTGFULLYEAR,
+			input:  "011200Z JAN 1900",
+			error:  ErrYearOutOfRange,
+		},
+		{
+			name:   "time layout year out of range",
+			layout: "2006-01-02",
+			input:  "1900-01-01",
+			error:  ErrYearOutOfRange,
+		},
+		{
+			name:   "layout without day",
+			layout: "hhmmZ MMM YYYY",
+			input:  "1200Z JAN 2024",
+			error:  ErrInvalidDateTimeGroup,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseLayout(tt.layout, tt.input)
+			if !errors.Is(err, tt.error) {
+				t.Fatalf("got %v, want %v", err, tt.error)
+			}
+
+			if err != nil {
+				var pe *ParseError
+				if !errors.As(err, &pe) {
+					t.Errorf("got %T, want *ParseError", err)
+				}
+
+				return
+			}
+
+			if !got.Equal(tt.want) {
+				t.Errorf("got %v, want %v", got.Time, tt.want)
+			}
+
+			_, gotOffset := got.Zone()
+			_, wantOffset := tt.want.Zone()
+			if gotOffset != wantOffset {
+				t.Errorf("got offset %d, want %d", gotOffset, wantOffset)
+			}
+		})
+	}
+}
+
+func TestParseLayout_ErrorOffset(t *testing.T) {
+	t.Parallel()
+
+	_, err := ParseLayout(MILDTGFULLYEAR, "011200Z JEN 2024")
+
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		t.Fatalf("got %T, want *ParseError", err)
+	}
+
+	if pe.Offset != 8 || pe.Component != ComponentMonth {
+		t.Errorf("got %v at %d, want month at 8", pe.Component, pe.Offset)
+	}
+}
+
+func TestParser_ParseLayout(t *testing.T) {
+	t.Parallel()
+
+	p := Parser{Reference: time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)}
+
+	got, err := p.ParseLayout("DDhhmmYYYY", "0112002024")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := time.Date(2024, 3, 1, 12, 0, 0, 0, ZULU.Location())
+	if !got.Equal(want) {
+		t.Errorf("got %v, want %v", got.Time, want)
+	}
+}
+
+func TestParser_ParseLayoutJuliet(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone database unavailable: %v", err)
+	}
+
+	p := Parser{DefaultZone: JULIET, Juliet: newYork}
+	want := time.Date(2024, 7, 1, 12, 0, 0, 0, newYork)
+
+	// The time layout is read in the parser's Juliet location, as is the
+	// date-time-group layout.
+	for _, tt := range []struct{ layout, input string }{
+		{layout: "2006-01-02 15:04", input: "2024-07-01 12:00"},
+		{layout: "DD hhmm MMM YYYY", input: "01 1200 JUL 2024"},
+	} {
+		got, err := p.ParseLayout(tt.layout, tt.input)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !got.Equal(want) || got.Location() != newYork {
+			t.Errorf("got %v, want %v", got.Time, want)
+		}
+	}
+}
+
+func TestParser_ParseLayoutJulian(t *testing.T) {
+	t.Parallel()
+
+	p := Parser{Reference: time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)}
+
+	got, err := p.ParseLayout("YDDDhhmmZ", "40151200Z")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := time.Date(2024, 1, 15, 12, 0, 0, 0, ZULU.Location())
+	if !got.Equal(want) {
+		t.Errorf("got %v, want %v", got.Time, want)
+	}
+}
+
+func TestParseLayoutInZone(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseLayoutInZone("DDhhmm MMM YYYY", "011200 JAN 2024", ROMEO)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := time.Date(2024, 1, 1, 12, 0, 0, 0, ROMEO.Location())
+	if !got.Equal(want) {
+		t.Errorf("got %v, want %v", got.Time, want)
+	}
+
+	// A zone in the input takes precedence.
+	got, err = ParseLayoutInZone(MILDTGFULLYEAR, "011200Z JAN 2024", ROMEO)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want = time.Date(2024, 1, 1, 12, 0, 0, 0, ZULU.Location())
+	if !got.Equal(want) {
+		t.Errorf("got %v, want %v", got.Time, want)
+	}
+}
@@ -0,0 +1,131 @@
+package mildtg
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalLayout is the layout used when a Time is marshaled to JSON, text
+// or binary. It must be either MILDTGFULLYEAR or MILDTGSHORTYEAR so that the
+// output can be read back with ParseDTG. The zone letter is always derived
+// from the UTC offset, never Juliet, so the same instant is read back on
+// any host.
+var MarshalLayout = MILDTGFULLYEAR
+
+var jsonNull = []byte("null")
+
+// MarshalJSON implements the json.Marshaler interface.
+// The time is a quoted date-time-group in the MarshalLayout format.
+// The zero Time is encoded as null, and a year the zero Formatter
+// rejects fails with ErrYearOutOfRange.
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return jsonNull, nil
+	}
+
+	b := make([]byte, 0, 32)
+	b = append(b, '"')
+	b, err := Formatter{}.AppendFormat(b, t, MarshalLayout)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(b, '"'), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The time must be a quoted date-time-group, an empty string or null.
+// Both null and the empty string leave the zero Time.
+func (t *Time) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, jsonNull) {
+		*t = Time{}
+		return nil
+	}
+
+	text, err := unquoteJSON(data, "mildtg.Time")
+	if err != nil {
+		return err
+	}
+
+	return t.UnmarshalText(text)
+}
+
+// unquoteJSON returns the contents of the JSON string data with its
+// escapes decoded, such as \u2020 for the dagger of "E†". It fails if
+// data is not a string, naming typ as the type being unmarshaled.
+func unquoteJSON(data []byte, typ string) ([]byte, error) {
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return nil, fmt.Errorf("%w: cannot unmarshal %s into %s", ErrInvalidDateTimeGroup, data, typ)
+	}
+
+	if bytes.IndexByte(data, '\\') < 0 {
+		return data[1 : len(data)-1], nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("%w: cannot unmarshal %s into %s: %v", ErrInvalidDateTimeGroup, data, typ, err)
+	}
+
+	return []byte(s), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The time is formatted as a date-time-group in the MarshalLayout format.
+// The zero Time is encoded as an empty string, and a year the zero
+// Formatter rejects fails with ErrYearOutOfRange.
+func (t Time) MarshalText() ([]byte, error) {
+	if t.IsZero() {
+		return []byte{}, nil
+	}
+
+	return Formatter{}.AppendFormat(nil, t, MarshalLayout)
+}
+
+// AppendText implements the encoding.TextAppender interface.
+// It appends the same encoding as MarshalText to b. Defining it keeps the
+// embedded time.Time method from being promoted in its place.
+func (t Time) AppendText(b []byte) ([]byte, error) {
+	if t.IsZero() {
+		return b, nil
+	}
+
+	return Formatter{}.AppendFormat(b, t, MarshalLayout)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The time must be a date-time-group accepted by ParseDTG or empty.
+func (t *Time) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*t = Time{}
+		return nil
+	}
+
+	out, err := ParseDTG(string(data))
+	if err != nil {
+		return err
+	}
+
+	*t = out
+
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// The encoding is the same as MarshalText.
+func (t Time) MarshalBinary() ([]byte, error) {
+	return t.MarshalText()
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface.
+// The encoding is the same as AppendText.
+func (t Time) AppendBinary(b []byte) ([]byte, error) {
+	return t.AppendText(b)
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// The encoding is the same as UnmarshalText.
+func (t *Time) UnmarshalBinary(data []byte) error {
+	return t.UnmarshalText(data)
+}
@@ -0,0 +1,210 @@
+package mildtg
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTime_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input Time
+		want  string
+	}{
+		{
+			name:  "zulu",
+			input: NewTime(time.Date(2021, 1, 1, 1, 0, 0, 0, ZULU.Location())),
+			want:  `"010100Z JAN 2021"`,
+		},
+		{
+			name:  "romeo with seconds",
+			input: NewTime(time.Date(2021, 1, 1, 1, 0, 59, 0, ROMEO.Location())),
+			want:  `"01010059R JAN 2021"`,
+		},
+		{
+			name:  "zero time",
+			input: Time{},
+			want:  `null`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if string(got) != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTime_MarshalJSONYearOutOfRange(t *testing.T) {
+	t.Parallel()
+
+	in := NewTime(time.Date(1900, 1, 1, 1, 0, 0, 0, ZULU.Location()))
+
+	if _, err := json.Marshal(in); !errors.Is(err, ErrYearOutOfRange) {
+		t.Errorf("got %v, want %v", err, ErrYearOutOfRange)
+	}
+
+	if _, err := in.MarshalText(); !errors.Is(err, ErrYearOutOfRange) {
+		t.Errorf("got %v, want %v", err, ErrYearOutOfRange)
+	}
+}
+
+func TestTime_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	echoDagger, _ := ZoneByDesignator("E†")
+
+	tests := []struct {
+		name  string
+		input string
+		want  Time
+		error error
+	}{
+		{
+			name:  "full year",
+			input: `"010100Z JAN 2021"`,
+			want:  NewTime(time.Date(2021, 1, 1, 1, 0, 0, 0, ZULU.Location())),
+		},
+		{
+			name:  "short year",
+			input: `"010100R JAN 21"`,
+			want:  NewTime(time.Date(2021, 1, 1, 1, 0, 0, 0, ROMEO.Location())),
+		},
+		{
+			name:  "escaped suffix",
+			input: `"011200E\u2020 JAN 2024"`,
+			want:  NewTime(time.Date(2024, 1, 1, 12, 0, 0, 0, echoDagger.Location())),
+		},
+		{
+			name:  "escaped letter",
+			input: `"010100\u005a JAN 2021"`,
+			want:  NewTime(time.Date(2021, 1, 1, 1, 0, 0, 0, ZULU.Location())),
+		},
+		{
+			name:  "null",
+			input: `null`,
+			want:  Time{},
+		},
+		{
+			name:  "empty string",
+			input: `""`,
+			want:  Time{},
+		},
+		{
+			name:  "not a string",
+			input: `20210101`,
+			want:  Time{},
+			error: ErrInvalidDateTimeGroup,
+		},
+		{
+			name:  "invalid month",
+			input: `"010100Z JEN 2021"`,
+			want:  Time{},
+			error: ErrInvalidDateTimeGroup,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Time
+			err := json.Unmarshal([]byte(tt.input), &got)
+			if !errors.Is(err, tt.error) {
+				t.Fatalf("got %v, want %v", err, tt.error)
+			}
+
+			if !got.Equal(tt.want.Time) || got.String() != tt.want.String() {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTime_JSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	type payload struct {
+		At   Time          `json:"at"`
+		Seen map[Time]bool `json:"seen"`
+	}
+
+	at := NewTime(time.Date(2024, 2, 29, 23, 59, 0, 0, ROMEO.Location()))
+	in := payload{At: at, Seen: map[Time]bool{at: true}}
+
+	b, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := `{"at":"292359R FEB 2024","seen":{"292359R FEB 2024":true}}`
+	if string(b) != want {
+		t.Fatalf("got %s, want %s", b, want)
+	}
+
+	var out payload
+	if err := json.Unmarshal(b, &out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !out.At.Equal(at.Time) {
+		t.Errorf("got %v, want %v", out.At, at)
+	}
+
+	if len(out.Seen) != 1 {
+		t.Errorf("got %d map entries, want 1", len(out.Seen))
+	}
+}
+
+func TestTime_MarshalBinary(t *testing.T) {
+	t.Parallel()
+
+	in := NewTime(time.Date(2021, 1, 1, 1, 0, 0, 0, ZULU.Location()))
+
+	b, err := in.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if string(b) != "010100Z JAN 2021" {
+		t.Errorf("got %s, want %s", b, "010100Z JAN 2021")
+	}
+
+	var out Time
+	if err := out.UnmarshalBinary(b); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !out.Equal(in.Time) {
+		t.Errorf("got %v, want %v", out, in)
+	}
+
+	if err := out.UnmarshalBinary([]byte("garbage")); !errors.Is(err, ErrInvalidDateTimeGroup) {
+		t.Errorf("got %v, want %v", err, ErrInvalidDateTimeGroup)
+	}
+}
+
+func TestTime_UnmarshalTextParseError(t *testing.T) {
+	t.Parallel()
+
+	var got Time
+	err := got.UnmarshalText([]byte("012500Z JAN 2021"))
+
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		t.Fatalf("got %T, want *ParseError", err)
+	}
+
+	if pe.Component != ComponentHour {
+		t.Errorf("got %v, want %v", pe.Component, ComponentHour)
+	}
+}
@@ -0,0 +1,50 @@
+// Package mildtgtest provides utilities for testing code that uses mildtg.
+package mildtgtest
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock is a mildtg.Clock whose time only changes when it is set or
+// advanced, so that inferred months and years do not depend on when the
+// test runs. It is safe for concurrent use.
+//
+//	clock := mildtgtest.NewClock(time.Date(2024, 3, 10, 15, 0, 0, 0, time.UTC))
+//	p := mildtg.Parser{Clock: clock}
+type Clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewClock returns a Clock set to now.
+func NewClock(now time.Time) *Clock {
+	return &Clock{now: now}
+}
+
+// Now returns the clock's current time.
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// Set sets the clock's current time to now.
+func (c *Clock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = now
+}
+
+// Advance moves the clock's current time forward by d, or back if d
+// is negative, and returns the new time.
+func (c *Clock) Advance(d time.Duration) time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+
+	return c.now
+}
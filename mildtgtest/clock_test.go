@@ -0,0 +1,72 @@
+package mildtgtest_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Type3Solutions/mildtg"
+	"github.com/Type3Solutions/mildtg/mildtgtest"
+)
+
+// The fake clock must satisfy the interface it stands in for.
+var _ mildtg.Clock = (*mildtgtest.Clock)(nil)
+
+func TestClock(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 3, 10, 15, 0, 0, 0, time.UTC)
+	clock := mildtgtest.NewClock(start)
+
+	if got := clock.Now(); !got.Equal(start) {
+		t.Errorf("got %v, want %v", got, start)
+	}
+
+	want := start.Add(90 * time.Minute)
+	if got := clock.Advance(90 * time.Minute); !got.Equal(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if got := clock.Now(); !got.Equal(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	want = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock.Set(want)
+	if got := clock.Now(); !got.Equal(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestClock_Parser(t *testing.T) {
+	t.Parallel()
+
+	clock := mildtgtest.NewClock(time.Date(2024, 3, 10, 15, 0, 0, 0, time.UTC))
+	p := mildtg.Parser{Clock: clock}
+
+	tests := []struct {
+		now  time.Time
+		want time.Time
+	}{
+		{
+			now:  time.Date(2024, 3, 10, 15, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 3, 1, 1, 0, 0, 0, time.UTC),
+		},
+		{
+			now:  time.Date(2024, 12, 31, 23, 0, 0, 0, time.UTC),
+			want: time.Date(2025, 1, 1, 1, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		clock.Set(tt.now)
+
+		got, err := p.Parse("010100Z")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !got.Equal(tt.want) {
+			t.Errorf("got %v, want %v", got, tt.want)
+		}
+	}
+}
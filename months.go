@@ -18,6 +18,9 @@ var (
 	months map[string]time.Month
 )
 
+// monthNames holds the upper-case name of each month, indexed by time.Month.
+var monthNames [13]string
+
 func init() {
 	months = make(map[string]time.Month)
 
@@ -27,6 +30,7 @@ func init() {
 	} {
 		months[strings.ToUpper(m.String()[:3])] = m
 		months[strings.ToUpper(m.String())] = m
+		monthNames[m] = strings.ToUpper(m.String())
 	}
 }
 
@@ -0,0 +1,296 @@
+package mildtg
+
+import (
+	"time"
+	"unsafe"
+)
+
+// Bias selects which date a Parser infers for a date-time-group
+// that omits its month, its year or both.
+type Bias int
+
+const (
+	// BiasNearest picks the date closest to the reference time.
+	BiasNearest Bias = iota
+
+	// BiasPast picks the latest date at or before the reference time,
+	// as when reading reports of events that have happened.
+	BiasPast
+
+	// BiasFuture picks the earliest date at or after the reference time,
+	// as when reading orders for events still to come.
+	BiasFuture
+)
+
+// Parser parses date-time-groups with configurable defaults.
+// The zero Parser behaves like ParseDTG.
+type Parser struct {
+	// Reference is the time used to infer a missing month or year and
+	// to place two-digit years when CenturyWindow is set.
+	// If zero, the current time of Clock is used.
+	Reference time.Time
+
+	// Clock provides the current time when Reference is zero. It is read
+	// once per parse. If nil, the package Clock set by SetClock is used.
+	Clock Clock
+
+	// Bias selects which date is inferred for a missing month or year.
+	// The zero value is BiasNearest.
+	Bias Bias
+
+	// CenturyWindow is the number of years after the reference year that a
+	// two-digit year may resolve to. With a window of 20 and a reference
+	// year of 2024, "44" is 2044 but "45" is 1945. It must be between
+	// 1 and 99, and parsing with any other non-zero window fails with
+	// ErrInvalidParser. If zero, years 69 through 99 are in the 20th
+	// century and years 00 through 68 are in the 21st century.
+	CenturyWindow int
+
+	// DefaultZone is the time zone used when none is given.
+	// If zero, ZULU is used.
+	DefaultZone TimeZone
+
+	// MinYear and MaxYear bound the parsed year, inclusive. Years outside
+	// the bounds are rejected with ErrYearOutOfRange. If zero, MinYear is
+	// 1941 and MaxYear is 9999.
+	MinYear int
+	MaxYear int
+
+	// Juliet is the location used for Juliet (J) times.
+	// If nil, time.Local is used.
+	Juliet *time.Location
+
+	// Strict requires the exact MILDTGFULLYEAR or MILDTGSHORTYEAR layout
+	// as described for ParseDTGStrict instead of accepting free-form input.
+	Strict bool
+}
+
+// Parse parses a military date-time-group string in the format
+// DDHH[MM]|[MMSS]|(A-Z)[ MMM YY[YY] and returns a Time object.
+func (p Parser) Parse(s string) (Time, error) {
+	if err := p.validate(s); err != nil {
+		return Time{}, err
+	}
+
+	p = p.withReference()
+
+	f, err := p.fields(s)
+	if err != nil {
+		return Time{}, err
+	}
+
+	return p.resolve(s, f)
+}
+
+// fields returns the fields given in the date-time-group s.
+func (p Parser) fields(s string) (dtgFields, error) {
+	if p.Strict {
+		return p.parseStrict(s)
+	}
+
+	return p.parseDTGBytes(s)
+}
+
+// ParseBytes is like Parse but parses a byte slice. It does not
+// allocate unless it returns an error, and b is not retained.
+func (p Parser) ParseBytes(b []byte) (Time, error) {
+	// The string shares memory with b, so the input reported in a
+	// ParseError is copied before returning.
+	t, err := p.Parse(unsafeString(b))
+	if pe, ok := err.(*ParseError); ok {
+		pe.Input = string(b)
+	}
+
+	return t, err
+}
+
+// unsafeString returns a string sharing the memory of b.
+// The string must not be used after b is modified or retained
+// beyond the call it is passed to.
+func unsafeString(b []byte) string {
+	return *(*string)(unsafe.Pointer(&b))
+}
+
+// reference returns the time used to fill in missing values.
+func (p Parser) reference() time.Time {
+	if !p.Reference.IsZero() {
+		return p.Reference
+	}
+
+	if p.Clock != nil {
+		return p.Clock.Now()
+	}
+
+	return currentClock().Now()
+}
+
+// withReference returns p with its Reference set, so that every value
+// inferred while parsing one date-time-group uses the same time.
+func (p Parser) withReference() Parser {
+	p.Reference = p.reference()
+	return p
+}
+
+// defaultZone returns the time zone used when none is given.
+func (p Parser) defaultZone() TimeZone {
+	if p.DefaultZone == (TimeZone{}) {
+		return ZULU
+	}
+
+	return p.DefaultZone
+}
+
+// julietLocation returns the location used for Juliet times.
+func (p Parser) julietLocation() *time.Location {
+	if p.Juliet == nil {
+		return time.Local
+	}
+
+	return p.Juliet
+}
+
+// location returns the location of tz, using the parser's Juliet location.
+func (p Parser) location(tz TimeZone) *time.Location {
+	if tz == JULIET {
+		return p.julietLocation()
+	}
+
+	return tz.Location()
+}
+
+// expandYear returns the four-digit year for the two-digit year y.
+func (p Parser) expandYear(y, refYear int) int {
+	if p.CenturyWindow == 0 {
+		// Determine if the year is in the 21st or 20th century.
+		if y < 69 {
+			return 2000 + y
+		}
+
+		return 1900 + y
+	}
+
+	year := refYear - refYear%100 + y
+	switch {
+	case year > refYear+p.CenturyWindow:
+		year -= 100
+	case year <= refYear+p.CenturyWindow-100:
+		year += 100
+	}
+
+	return year
+}
+
+// validate returns a *ParseError for s wrapping ErrInvalidParser if the
+// parser's CenturyWindow or year bounds cannot be used.
+func (p Parser) validate(s string) error {
+	if p.CenturyWindow < 0 || p.CenturyWindow > 99 {
+		return newParseError(s, 0, ComponentYear, ErrInvalidParser,
+			"century window %d is not between 1 and 99", p.CenturyWindow)
+	}
+
+	if lo, hi := p.yearBounds(); lo > hi {
+		return newParseError(s, 0, ComponentYear, ErrInvalidParser,
+			"minimum year %d is after maximum year %d", lo, hi)
+	}
+
+	return nil
+}
+
+// yearBounds returns the smallest and largest year the parser accepts.
+func (p Parser) yearBounds() (int, int) {
+	return yearBounds(p.MinYear, p.MaxYear)
+}
+
+// yearInRange reports whether year is within the parser's bounds.
+func (p Parser) yearInRange(year int) bool {
+	lo, hi := p.yearBounds()
+	return year >= lo && year <= hi
+}
+
+// yearBounds returns lo and hi, replacing zero values with the
+// package limits minYear and maxYear.
+func yearBounds(lo, hi int) (int, int) {
+	if lo == 0 {
+		lo = minYear
+	}
+
+	if hi == 0 {
+		hi = maxYear
+	}
+
+	return lo, hi
+}
+
+// inferDate returns the year and month for a date-time-group missing its
+// month, its year or both. Candidates are the months around the reference
+// time when both are missing, the years around it when only the year is
+// missing, and the months of the given year when only the month is missing.
+// Candidates on which the day does not exist are skipped, and the Bias
+// selects among the rest. The found result reports whether a candidate
+// was selected, and validDay whether the day exists in any candidate.
+func (p Parser) inferDate(year int, month time.Month, day, hour, minute, seconds int,
+	loc *time.Location, hasMonth, hasYear bool) (y int, m time.Month, found, validDay bool) {
+	ref := p.reference()
+	local := ref.In(loc)
+
+	var best time.Time
+
+	consider := func(cy int, cm time.Month) {
+		if day > daysInMonth(cm, cy) || day < 1 {
+			return
+		}
+
+		validDay = true
+
+		if !p.yearInRange(cy) {
+			return
+		}
+
+		c := time.Date(cy, cm, day, hour, minute, seconds, 0, loc)
+
+		switch p.Bias {
+		case BiasPast:
+			if c.After(ref) || found && !c.After(best) {
+				return
+			}
+		case BiasFuture:
+			if c.Before(ref) || found && !c.Before(best) {
+				return
+			}
+		default:
+			if found && absDuration(c.Sub(ref)) >= absDuration(best.Sub(ref)) {
+				return
+			}
+		}
+
+		best, found = c, true
+	}
+
+	switch {
+	case !hasMonth && !hasYear:
+		for i := -12; i <= 12; i++ {
+			first := time.Date(local.Year(), local.Month()+time.Month(i), 1, 0, 0, 0, 0, time.UTC)
+			consider(first.Year(), first.Month())
+		}
+	case !hasYear:
+		// February 29 may be eight years away across a century.
+		for cy := local.Year() - 8; cy <= local.Year()+8; cy++ {
+			consider(cy, month)
+		}
+	default:
+		for cm := time.January; cm <= time.December; cm++ {
+			consider(year, cm)
+		}
+	}
+
+	return best.Year(), best.Month(), found, validDay
+}
+
+// absDuration returns the absolute value of d.
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+
+	return d
+}
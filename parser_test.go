@@ -0,0 +1,329 @@
+package mildtg
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParser_Parse(t *testing.T) {
+	t.Parallel()
+
+	reference := time.Date(2024, time.March, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		parser Parser
+		input  string
+		want   time.Time
+		zone   string
+		error  error
+	}{
+		{
+			name:   "reference fills month and year",
+			parser: Parser{Reference: reference},
+			input:  "010100Z",
+			want:   time.Date(2024, time.March, 1, 1, 0, 0, 0, time.UTC),
+			zone:   "Z",
+		},
+		{
+			name:   "reference fills year",
+			parser: Parser{Reference: reference},
+			input:  "010100Z JUL",
+			want:   time.Date(2024, time.July, 1, 1, 0, 0, 0, time.UTC),
+			zone:   "Z",
+		},
+		{
+			name:   "fixed pivot without century window",
+			parser: Parser{Reference: reference},
+			input:  "010100Z JAN 69",
+			want:   time.Date(1969, time.January, 1, 1, 0, 0, 0, time.UTC),
+			zone:   "Z",
+		},
+		{
+			name:   "century window inside window",
+			parser: Parser{Reference: reference, CenturyWindow: 20},
+			input:  "010100Z JAN 44",
+			want:   time.Date(2044, time.January, 1, 1, 0, 0, 0, time.UTC),
+			zone:   "Z",
+		},
+		{
+			name:   "century window past window",
+			parser: Parser{Reference: reference, CenturyWindow: 20},
+			input:  "010100Z JAN 45",
+			want:   time.Date(1945, time.January, 1, 1, 0, 0, 0, time.UTC),
+			zone:   "Z",
+		},
+		{
+			name:   "century window slides with reference",
+			parser: Parser{Reference: time.Date(2090, 1, 1, 0, 0, 0, 0, time.UTC), CenturyWindow: 20},
+			input:  "010100Z JAN 05",
+			want:   time.Date(2105, time.January, 1, 1, 0, 0, 0, time.UTC),
+			zone:   "Z",
+		},
+		{
+			name:   "century window of one",
+			parser: Parser{Reference: reference, CenturyWindow: 1},
+			input:  "010100Z JAN 25",
+			want:   time.Date(2025, time.January, 1, 1, 0, 0, 0, time.UTC),
+			zone:   "Z",
+		},
+		{
+			name:   "century window of one past window",
+			parser: Parser{Reference: reference, CenturyWindow: 1, MinYear: 1900},
+			input:  "010100Z JAN 26",
+			want:   time.Date(1926, time.January, 1, 1, 0, 0, 0, time.UTC),
+			zone:   "Z",
+		},
+		{
+			name:   "century window of 100",
+			parser: Parser{Reference: reference, CenturyWindow: 100},
+			input:  "010100Z JAN 24",
+			error:  ErrInvalidParser,
+		},
+		{
+			name:   "negative century window",
+			parser: Parser{Reference: reference, CenturyWindow: -1},
+			input:  "010100Z JAN 24",
+			error:  ErrInvalidParser,
+		},
+		{
+			name:   "default zone",
+			parser: Parser{Reference: reference, DefaultZone: ROMEO},
+			input:  "010100 JAN 24",
+			want:   time.Date(2024, time.January, 1, 6, 0, 0, 0, time.UTC),
+			zone:   "R",
+		},
+		{
+			name:   "explicit zone overrides default zone",
+			parser: Parser{Reference: reference, DefaultZone: ROMEO},
+			input:  "010100Z JAN 24",
+			want:   time.Date(2024, time.January, 1, 1, 0, 0, 0, time.UTC),
+			zone:   "Z",
+		},
+		{
+			name:   "year below minimum",
+			parser: Parser{MinYear: 1941},
+			input:  "010100Z JAN 1940",
+			error:  ErrYearOutOfRange,
+		},
+		{
+			name:   "year above maximum",
+			parser: Parser{MaxYear: 2030},
+			input:  "010100Z JAN 2031",
+			error:  ErrYearOutOfRange,
+		},
+		{
+			name:   "year below default minimum",
+			parser: Parser{},
+			input:  "010100Z JAN 0001",
+			error:  ErrYearOutOfRange,
+		},
+		{
+			name:   "historical year with lower minimum",
+			parser: Parser{MinYear: 1},
+			input:  "010100Z JAN 0001",
+			want:   time.Date(1, time.January, 1, 1, 0, 0, 0, time.UTC),
+			zone:   "Z",
+		},
+		{
+			name:   "year at bounds",
+			parser: Parser{MinYear: 2031, MaxYear: 2031},
+			input:  "010100Z JAN 2031",
+			want:   time.Date(2031, time.January, 1, 1, 0, 0, 0, time.UTC),
+			zone:   "Z",
+		},
+		{
+			name:   "minimum after maximum",
+			parser: Parser{MinYear: 2030, MaxYear: 2000},
+			input:  "010100Z JAN 2020",
+			error:  ErrInvalidParser,
+		},
+		{
+			name:   "maximum before default minimum",
+			parser: Parser{MaxYear: 1900},
+			input:  "010100Z JAN 1900",
+			error:  ErrInvalidParser,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parser.Parse(tt.input)
+			if !errors.Is(err, tt.error) {
+				t.Fatalf("got %v, want %v", err, tt.error)
+			}
+
+			if !got.Equal(tt.want) {
+				t.Errorf("got %v, want %v", got.UTC(), tt.want)
+			}
+
+			if name, _ := got.Zone(); err == nil && name != tt.zone {
+				t.Errorf("got zone %v, want %v", name, tt.zone)
+			}
+		})
+	}
+}
+
+func TestParser_InvalidParser(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		parse func(Parser) error
+	}{
+		{name: "Parse", parse: func(p Parser) error { _, err := p.Parse("010100Z JAN 24"); return err }},
+		{name: "ParseDetailed", parse: func(p Parser) error { _, err := p.ParseDetailed("010100Z JAN 24"); return err }},
+		{name: "ParseDate", parse: func(p Parser) error { _, err := p.ParseDate("01 JAN 24"); return err }},
+		{name: "ParseJulian", parse: func(p Parser) error { _, err := p.ParseJulian("24001"); return err }},
+		{name: "ParseLayout", parse: func(p Parser) error { _, err := p.ParseLayout("YYDDD", "24001"); return err }},
+		{name: "time layout", parse: func(p Parser) error { _, err := p.ParseLayout("2006-01-02", "2024-01-01"); return err }},
+		{name: "ParseAny", parse: func(p Parser) error { _, _, err := p.ParseAny("2024-01-01T00:00:00Z"); return err }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, p := range []Parser{{CenturyWindow: 100}, {MinYear: 2030, MaxYear: 2000}} {
+				err := tt.parse(p)
+				if !errors.Is(err, ErrInvalidParser) {
+					t.Errorf("got %v, want %v", err, ErrInvalidParser)
+				}
+
+				var pe *ParseError
+				if !errors.As(err, &pe) {
+					t.Errorf("got %T, want *ParseError", err)
+				}
+			}
+		})
+	}
+
+	if got := (Parser{MinYear: 2030, MaxYear: 2000}).FindAll("011200Z JAN 2020"); len(got) != 0 {
+		t.Errorf("got %v, want no matches", got)
+	}
+}
+
+func TestParser_ParseJuliet(t *testing.T) {
+	t.Parallel()
+
+	loc := time.FixedZone("EST", -5*3600)
+	p := Parser{Juliet: loc, Reference: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)}
+
+	got, err := p.Parse("010100J")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got.Location() != loc {
+		t.Errorf("got %v, want %v", got.Location(), loc)
+	}
+
+	if want := time.Date(2024, 1, 1, 6, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("got %v, want %v", got.UTC(), want)
+	}
+}
+
+func TestParser_ParseInference(t *testing.T) {
+	t.Parallel()
+
+	// The first minute of March 1, 2024.
+	reference := time.Date(2024, time.March, 1, 0, 1, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		bias  Bias
+		input string
+		want  time.Time
+		error error
+	}{
+		{
+			name:  "end of previous month is nearest",
+			input: "292300Z",
+			want:  time.Date(2024, time.February, 29, 23, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "day missing from previous month",
+			input: "302300Z",
+			want:  time.Date(2024, time.March, 30, 23, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "day missing from previous month with past bias",
+			bias:  BiasPast,
+			input: "302300Z",
+			want:  time.Date(2024, time.January, 30, 23, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "start of current month is nearest",
+			input: "020000Z",
+			want:  time.Date(2024, time.March, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "past bias",
+			bias:  BiasPast,
+			input: "020000Z",
+			want:  time.Date(2024, time.February, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "future bias",
+			bias:  BiasFuture,
+			input: "292300Z",
+			want:  time.Date(2024, time.March, 29, 23, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "future bias skips months without the day",
+			bias:  BiasFuture,
+			input: "312300Z",
+			want:  time.Date(2024, time.March, 31, 23, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "year from nearest december",
+			input: "312300Z DEC",
+			want:  time.Date(2023, time.December, 31, 23, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "year with future bias",
+			bias:  BiasFuture,
+			input: "312300Z DEC",
+			want:  time.Date(2024, time.December, 31, 23, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "leap day from nearest leap year",
+			bias:  BiasFuture,
+			input: "290000Z FEB",
+			want:  time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "month within the given year",
+			input: "0100002023",
+			want:  time.Date(2023, time.December, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "month with future bias and past year",
+			bias:  BiasFuture,
+			input: "0100002023",
+			error: ErrInvalidDateTimeGroup,
+		},
+		{
+			name:  "zone moves the reference date",
+			input: "291800R",
+			want:  time.Date(2024, time.February, 29, 23, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "day that never exists",
+			input: "320000Z",
+			error: ErrInvalidDay,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parser{Reference: reference, Bias: tt.bias}.Parse(tt.input)
+			if !errors.Is(err, tt.error) {
+				t.Fatalf("got %v, want %v", err, tt.error)
+			}
+
+			if !got.Equal(tt.want) {
+				t.Errorf("got %v, want %v", got.UTC(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package mildtg
+
+// Precision is the smallest unit of time given in a date-time-group.
+type Precision int
+
+const (
+	// PrecisionMinute is a date-time-group ending with the minute.
+	PrecisionMinute Precision = iota
+
+	// PrecisionSecond is a date-time-group that includes seconds.
+	PrecisionSecond
+)
+
+// String returns the name of the unit, such as "minute".
+func (p Precision) String() string {
+	switch p {
+	case PrecisionMinute:
+		return "minute"
+	case PrecisionSecond:
+		return "second"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseResult describes a parsed date-time-group: the Time it represents
+// and which of its optional components were written in the input rather
+// than filled in by the Parser.
+type ParseResult struct {
+	// Time is the parsed time.
+	Time Time
+
+	// Input is the date-time-group as given.
+	Input string
+
+	// Canonical is Time written in the MILDTGFULLYEAR layout, with the
+	// seconds written whenever the Precision is PrecisionSecond and
+	// midnight written as 2400 when EndOfDay is set.
+	Canonical string
+
+	// Precision is the smallest unit given in the input.
+	Precision Precision
+
+	// HasSeconds, HasZone, HasMonth and HasYear report whether the
+	// component was given in the input. A missing zone defaults to
+	// the Parser's DefaultZone, and a missing month or year is inferred
+	// from its Reference time.
+	HasSeconds bool
+	HasZone    bool
+	HasMonth   bool
+	HasYear    bool
+
+	// EndOfDay reports whether the time was written as 2400, meaning
+	// midnight at the end of the day given in the input.
+	EndOfDay bool
+}
+
+// Inferred reports whether the month or year of the date was inferred
+// rather than given in the input.
+func (r ParseResult) Inferred() bool {
+	return !r.HasMonth || !r.HasYear
+}
+
+// ParseDTGDetailed is like ParseDTG but also reports which components
+// of the date-time-group were given.
+//
+// ParseDTGDetailed is equivalent to Parser{}.ParseDetailed(s).
+func ParseDTGDetailed(s string) (ParseResult, error) {
+	return Parser{}.ParseDetailed(s)
+}
+
+// ParseDetailed is like Parse but also reports which components of the
+// date-time-group were given.
+func (p Parser) ParseDetailed(s string) (ParseResult, error) {
+	if err := p.validate(s); err != nil {
+		return ParseResult{}, err
+	}
+
+	p = p.withReference()
+
+	f, err := p.fields(s)
+	if err != nil {
+		return ParseResult{}, err
+	}
+
+	t, err := p.resolve(s, f)
+	if err != nil {
+		return ParseResult{}, err
+	}
+
+	r := ParseResult{
+		Time:       t,
+		Input:      s,
+		Precision:  PrecisionMinute,
+		HasSeconds: f.hasSeconds,
+		HasZone:    f.hasZone,
+		HasMonth:   f.hasMonth,
+		HasYear:    f.hasYear,
+		EndOfDay:   f.hour == 24,
+	}
+
+	hour := "hh"
+	if r.EndOfDay {
+		hour = "kk"
+	}
+
+	seconds := ""
+	if f.hasSeconds {
+		r.Precision = PrecisionSecond
+		seconds = "ss"
+	}
+
+	layout := "DD" + hour + "mm" + seconds + "Z MMM YYYY"
+
+	// Juliet times are written with the J designator they were parsed in,
+	// and years are bounded as for parsing.
+	formatter := Formatter{Juliet: p.julietLocation(), MinYear: p.MinYear, MaxYear: p.MaxYear}
+
+	r.Canonical, err = formatter.Format(t, layout)
+	if err != nil {
+		return ParseResult{}, newParseError(s, f.yearPos, ComponentYear, err, "%v", err)
+	}
+
+	return r, nil
+}
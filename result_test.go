@@ -0,0 +1,182 @@
+package mildtg
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParser_ParseDetailed(t *testing.T) {
+	t.Parallel()
+
+	p := Parser{Reference: time.Date(2024, 3, 1, 0, 1, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name  string
+		input string
+		want  ParseResult
+	}{
+		{
+			name:  "all components",
+			input: "01120030R JAN 2024",
+			want: ParseResult{
+				Time:       NewTime(time.Date(2024, 1, 1, 12, 0, 30, 0, ROMEO.Location())),
+				Input:      "01120030R JAN 2024",
+				Canonical:  "01120030R JAN 2024",
+				Precision:  PrecisionSecond,
+				HasSeconds: true,
+				HasZone:    true,
+				HasMonth:   true,
+				HasYear:    true,
+			},
+		},
+		{
+			name:  "zero seconds",
+			input: "01120000Z JAN 24",
+			want: ParseResult{
+				Time:       NewTime(time.Date(2024, 1, 1, 12, 0, 0, 0, ZULU.Location())),
+				Input:      "01120000Z JAN 24",
+				Canonical:  "01120000Z JAN 2024",
+				Precision:  PrecisionSecond,
+				HasSeconds: true,
+				HasZone:    true,
+				HasMonth:   true,
+				HasYear:    true,
+			},
+		},
+		{
+			name:  "day, hour and minute only",
+			input: "281200",
+			want: ParseResult{
+				Time:      NewTime(time.Date(2024, 2, 28, 12, 0, 0, 0, ZULU.Location())),
+				Input:     "281200",
+				Canonical: "281200Z FEB 2024",
+				Precision: PrecisionMinute,
+			},
+		},
+		{
+			name:  "end of day",
+			input: "312400Z DEC 23",
+			want: ParseResult{
+				Time:      NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, ZULU.Location())),
+				Input:     "312400Z DEC 23",
+				Canonical: "312400Z DEC 2023",
+				Precision: PrecisionMinute,
+				HasZone:   true,
+				HasMonth:  true,
+				HasYear:   true,
+				EndOfDay:  true,
+			},
+		},
+		{
+			name:  "no year",
+			input: "011200R JAN",
+			want: ParseResult{
+				Time:      NewTime(time.Date(2024, 1, 1, 12, 0, 0, 0, ROMEO.Location())),
+				Input:     "011200R JAN",
+				Canonical: "011200R JAN 2024",
+				Precision: PrecisionMinute,
+				HasZone:   true,
+				HasMonth:  true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := p.ParseDetailed(tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !got.Time.Equal(tt.want.Time.Time) {
+				t.Errorf("got %v, want %v", got.Time, tt.want.Time)
+			}
+
+			got.Time = tt.want.Time
+			if got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+
+			inferred := !tt.want.HasMonth || !tt.want.HasYear
+			if got.Inferred() != inferred {
+				t.Errorf("got inferred %v, want %v", got.Inferred(), inferred)
+			}
+		})
+	}
+}
+
+func TestParseDTGDetailed_Error(t *testing.T) {
+	t.Parallel()
+
+	_, err := ParseDTGDetailed("012500Z JAN 2024")
+	if !errors.Is(err, ErrInvalidDateTimeGroup) {
+		t.Errorf("got %v, want %v", err, ErrInvalidDateTimeGroup)
+	}
+}
+
+func TestParser_ParseDetailedJuliet(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone database unavailable: %v", err)
+	}
+
+	got, err := Parser{Juliet: newYork}.ParseDetailed("010100J JUL 2024")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got.Canonical != "010100J JUL 2024" {
+		t.Errorf("got %v, want %v", got.Canonical, "010100J JUL 2024")
+	}
+}
+
+func TestPrecision_String(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input Precision
+		want  string
+	}{
+		{input: PrecisionMinute, want: "minute"},
+		{input: PrecisionSecond, want: "second"},
+		{input: Precision(-1), want: "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.input.String(); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParser_ParseDetailedYearBounds(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		parser Parser
+		input  string
+		want   string
+	}{
+		{name: "before default min year", parser: Parser{MinYear: 1900}, input: "010000Z JAN 1920", want: "010000Z JAN 1920"},
+		{name: "after max year", parser: Parser{MaxYear: 99999}, input: "312400Z DEC 9999", want: "312400Z DEC 9999"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parser.ParseDetailed(tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got.Canonical != tt.want {
+				t.Errorf("got %v, want %v", got.Canonical, tt.want)
+			}
+		})
+	}
+}
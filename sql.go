@@ -0,0 +1,172 @@
+package mildtg
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"time"
+)
+
+// sqlTimestampLayouts are the text forms in which drivers return a
+// time.Time, such as one written by Time.Value to a text column or a
+// MySQL DATETIME read without parseTime.
+var sqlTimestampLayouts = [...]string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+}
+
+// Scan implements the sql.Scanner interface.
+//
+// Native timestamp columns (time.Time) are normalized to UTC and keep the
+// Zulu designator. Text columns (string or []byte) hold either a
+// date-time-group accepted by ParseDTG, and the parsed time keeps the
+// letter time zone written in the column, or a timestamp in RFC 3339 or
+// SQL form, such as "2024-01-01 12:00:00", which is normalized as for
+// native timestamp columns. A timestamp without an offset is UTC. A NULL
+// value leaves the zero Time.
+func (t *Time) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*t = Time{}
+		return nil
+	case time.Time:
+		*t = NewTime(v.In(ZULU.Location()))
+		return nil
+	case string:
+		return t.scanText(v)
+	case []byte:
+		return t.scanText(string(v))
+	default:
+		return fmt.Errorf("%w: cannot scan %T into mildtg.Time", ErrInvalidDateTimeGroup, src)
+	}
+}
+
+// scanText sets t from text read from a column, which is a
+// date-time-group or else a timestamp in one of sqlTimestampLayouts. If
+// neither parses, the error of the date-time-group is returned.
+func (t *Time) scanText(s string) error {
+	err := t.UnmarshalText([]byte(s))
+	if err == nil {
+		return nil
+	}
+
+	for _, layout := range sqlTimestampLayouts {
+		if v, perr := time.Parse(layout, s); perr == nil {
+			*t = NewTime(v.In(ZULU.Location()))
+			return nil
+		}
+	}
+
+	return err
+}
+
+// Value implements the driver.Valuer interface.
+// The time is written as a UTC time.Time so that it can be stored in native
+// timestamp columns. Drivers that store it as text write a timestamp that
+// Scan reads back. Use MarshalText to store a date-time-group in a text
+// column instead.
+func (t Time) Value() (driver.Value, error) {
+	return t.Time.UTC(), nil
+}
+
+// NullTime represents a Time that may be null.
+// NullTime implements the sql.Scanner interface so
+// it can be used as a scan destination, similar to sql.NullTime.
+type NullTime struct {
+	Time  Time
+	Valid bool // Valid is true if Time is not NULL
+}
+
+// Scan implements the sql.Scanner interface.
+func (nt *NullTime) Scan(src interface{}) error {
+	if src == nil {
+		nt.Time, nt.Valid = Time{}, false
+		return nil
+	}
+
+	if err := nt.Time.Scan(src); err != nil {
+		nt.Valid = false
+		return err
+	}
+
+	nt.Valid = true
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (nt NullTime) Value() (driver.Value, error) {
+	if !nt.Valid {
+		return nil, nil
+	}
+
+	return nt.Time.Value()
+}
+
+// Scan implements the sql.Scanner interface.
+//
+// Native date columns (time.Time) give the date in the value's own time
+// zone. Text columns (string or []byte) must hold a date accepted by
+// ParseDate. A NULL value leaves the zero Date.
+func (d *Date) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*d = Date{}
+		return nil
+	case time.Time:
+		*d = DateOf(NewTime(v))
+		return nil
+	case string:
+		return d.UnmarshalText([]byte(v))
+	case []byte:
+		return d.UnmarshalText(v)
+	default:
+		return fmt.Errorf("%w: cannot scan %T into mildtg.Date", ErrInvalidDateTimeGroup, src)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// The date is written as a time.Time at midnight UTC so that it can be
+// stored in native date columns. A date that does not exist, including
+// the zero Date, is rejected with ErrInvalidDay.
+func (d Date) Value() (driver.Value, error) {
+	if err := d.validate(); err != nil {
+		return nil, err
+	}
+
+	return d.In(ZULU).Time.UTC(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+//
+// Native time columns (time.Time) are normalized to UTC and keep the
+// Zulu designator. Text columns (string or []byte) must hold a time
+// accepted by ParseTimeOfDay. A NULL value leaves the zero TimeOfDay.
+func (tod *TimeOfDay) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*tod = TimeOfDay{}
+		return nil
+	case time.Time:
+		*tod = TimeOfDayOf(NewTime(v.In(ZULU.Location())))
+		return nil
+	case string:
+		return tod.UnmarshalText([]byte(v))
+	case []byte:
+		return tod.UnmarshalText(v)
+	default:
+		return fmt.Errorf("%w: cannot scan %T into mildtg.TimeOfDay", ErrInvalidDateTimeGroup, src)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// The time is written as a string in the MILTIME layout, such as "1430R",
+// since native time columns do not keep the time zone.
+func (tod TimeOfDay) Value() (driver.Value, error) {
+	b, err := tod.appendFormat(nil, MILTIME)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,272 @@
+package mildtg
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTime_Scan(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone database unavailable: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		input interface{}
+		want  Time
+		error error
+	}{
+		{
+			name:  "nil",
+			input: nil,
+			want:  Time{},
+		},
+		{
+			name:  "timestamp normalized to zulu",
+			input: time.Date(2021, 1, 1, 1, 0, 0, 0, newYork),
+			want:  NewTime(time.Date(2021, 1, 1, 6, 0, 0, 0, ZULU.Location())),
+		},
+		{
+			name:  "text keeps letter zone",
+			input: "010100R JAN 2021",
+			want:  NewTime(time.Date(2021, 1, 1, 1, 0, 0, 0, ROMEO.Location())),
+		},
+		{
+			name:  "bytes keep letter zone",
+			input: []byte("010100R JAN 2021"),
+			want:  NewTime(time.Date(2021, 1, 1, 1, 0, 0, 0, ROMEO.Location())),
+		},
+		{
+			name:  "rfc 3339 text normalized to zulu",
+			input: "2021-01-01T01:00:00-05:00",
+			want:  NewTime(time.Date(2021, 1, 1, 6, 0, 0, 0, ZULU.Location())),
+		},
+		{
+			name:  "sql timestamp bytes",
+			input: []byte("2021-01-01 06:00:00.5"),
+			want:  NewTime(time.Date(2021, 1, 1, 6, 0, 0, 5e8, ZULU.Location())),
+		},
+		{
+			name:  "invalid text",
+			input: "not a dtg",
+			want:  Time{},
+			error: ErrInvalidDateTimeGroup,
+		},
+		{
+			name:  "unsupported type",
+			input: int64(1609462800),
+			want:  Time{},
+			error: ErrInvalidDateTimeGroup,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Time
+			err := got.Scan(tt.input)
+			if !errors.Is(err, tt.error) {
+				t.Fatalf("got %v, want %v", err, tt.error)
+			}
+
+			if !got.Equal(tt.want.Time) || got.String() != tt.want.String() {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTime_Value(t *testing.T) {
+	t.Parallel()
+
+	in := NewTime(time.Date(2021, 1, 1, 1, 0, 0, 0, ROMEO.Location()))
+
+	v, err := in.Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, ok := v.(time.Time)
+	if !ok {
+		t.Fatalf("got %T, want time.Time", v)
+	}
+
+	if !got.Equal(in.Time) || got.Location() != time.UTC {
+		t.Errorf("got %v, want %v in UTC", got, in.Time)
+	}
+}
+
+func TestTime_ValueScanText(t *testing.T) {
+	t.Parallel()
+
+	in := NewTime(time.Date(2021, 1, 1, 1, 0, 0, 0, ROMEO.Location()))
+
+	v, err := in.Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The forms in which drivers store a time.Time in a text column.
+	ts := v.(time.Time)
+	for _, s := range []string{
+		ts.Format(time.RFC3339Nano),
+		ts.Format("2006-01-02 15:04:05.999999999-07:00"),
+		ts.Format("2006-01-02 15:04:05.999999"),
+	} {
+		var got Time
+		if err := got.Scan(s); err != nil {
+			t.Fatalf("unexpected error for %q: %v", s, err)
+		}
+
+		if !got.Equal(in.Time) || got.String() != "010600Z JAN 21" {
+			t.Errorf("got %v, want %v", got, "010600Z JAN 21")
+		}
+	}
+}
+
+func TestNullTime(t *testing.T) {
+	t.Parallel()
+
+	var nt NullTime
+	if err := nt.Scan(nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if nt.Valid {
+		t.Errorf("got valid, want invalid")
+	}
+
+	if v, err := nt.Value(); err != nil || v != nil {
+		t.Errorf("got %v, %v, want nil, nil", v, err)
+	}
+
+	if err := nt.Scan("010100Z JAN 2021"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !nt.Valid || nt.Time.String() != "010100Z JAN 21" {
+		t.Errorf("got %v (valid %v), want 010100Z JAN 21", nt.Time, nt.Valid)
+	}
+
+	if v, err := nt.Value(); err != nil || v == nil {
+		t.Errorf("got %v, %v, want a time.Time", v, err)
+	}
+
+	if err := nt.Scan("bad"); !errors.Is(err, ErrInvalidDateTimeGroup) {
+		t.Errorf("got %v, want %v", err, ErrInvalidDateTimeGroup)
+	}
+
+	if nt.Valid {
+		t.Errorf("got valid, want invalid after failed scan")
+	}
+}
+
+func TestDate_Scan(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input interface{}
+		want  Date
+		error error
+	}{
+		{name: "nil", input: nil, want: Date{}},
+		{name: "date column", input: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), want: Date{2024, time.January, 15}},
+		{name: "text", input: "15 JAN 2024", want: Date{2024, time.January, 15}},
+		{name: "bytes", input: []byte("15JAN24"), want: Date{2024, time.January, 15}},
+		{name: "invalid text", input: "2024-01-15", error: ErrInvalidDateTimeGroup},
+		{name: "unsupported type", input: int64(20240115), error: ErrInvalidDateTimeGroup},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Date
+			if err := got.Scan(tt.input); !errors.Is(err, tt.error) {
+				t.Fatalf("got %v, want %v", err, tt.error)
+			}
+
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDate_Value(t *testing.T) {
+	t.Parallel()
+
+	got, err := Date{2024, time.January, 15}.Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	if v, ok := got.(time.Time); !ok || !v.Equal(want) || v.Location() != time.UTC {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if _, err := (Date{}).Value(); !errors.Is(err, ErrInvalidDay) {
+		t.Errorf("got %v, want %v", err, ErrInvalidDay)
+	}
+}
+
+func TestTimeOfDay_Scan(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input interface{}
+		want  TimeOfDay
+		error error
+	}{
+		{name: "nil", input: nil, want: TimeOfDay{}},
+		{
+			name:  "time column normalized to zulu",
+			input: time.Date(0, 1, 1, 14, 30, 0, 0, time.FixedZone("", -5*60*60)),
+			want:  TimeOfDay{Hour: 19, Minute: 30, Zone: ZULU},
+		},
+		{name: "text", input: "1430R", want: TimeOfDay{Hour: 14, Minute: 30, Zone: ROMEO}},
+		{name: "end of day", input: "2400Z", want: TimeOfDay{Hour: 24, Zone: ZULU}},
+		{name: "start of day", input: "0000Z", want: TimeOfDay{}},
+		{name: "bytes", input: []byte("1430 HOURS"), want: TimeOfDay{Hour: 14, Minute: 30, Zone: ZULU}},
+		{name: "invalid text", input: "14:30", error: ErrInvalidDateTimeGroup},
+		{name: "unsupported type", input: int64(1430), error: ErrInvalidDateTimeGroup},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got TimeOfDay
+			if err := got.Scan(tt.input); !errors.Is(err, tt.error) {
+				t.Fatalf("got %v, want %v", err, tt.error)
+			}
+
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeOfDay_Value(t *testing.T) {
+	t.Parallel()
+
+	got, err := TimeOfDay{Hour: 14, Minute: 30, Zone: ROMEO}.Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got != "1430R" {
+		t.Errorf("got %v, want %v", got, "1430R")
+	}
+
+	if got, err = (TimeOfDay{Hour: 24, Zone: ZULU}).Value(); err != nil || got != "2400Z" {
+		t.Errorf("got %v, %v, want %v", got, err, "2400Z")
+	}
+
+	if _, err := (TimeOfDay{Hour: 25}).Value(); !errors.Is(err, ErrInvalidDateTimeGroup) {
+		t.Errorf("got %v, want %v", err, ErrInvalidDateTimeGroup)
+	}
+}
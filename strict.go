@@ -0,0 +1,217 @@
+package mildtg
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// ParseDTGStrict parses a date-time-group that must exactly match the
+// MILDTGFULLYEAR or MILDTGSHORTYEAR layout, such as "011200Z JAN 24" or
+// "01120030Z JAN 2024". The time zone is required, fields are separated
+// by single spaces, and letters must be upper case. Any deviation is
+// reported as a *ParseError.
+//
+// ParseDTGStrict is equivalent to Parser{Strict: true}.Parse(s).
+func ParseDTGStrict(s string) (Time, error) {
+	return Parser{Strict: true}.Parse(s)
+}
+
+// parseStrict returns the fields of a date-time-group in the canonical layout.
+func (p Parser) parseStrict(s string) (dtgFields, error) {
+	var f dtgFields
+
+	// Day, hour and minute, then optional seconds.
+	digits := [...]struct {
+		c   Component
+		v   *int
+		pos *int
+	}{
+		{ComponentDay, &f.day, &f.dayPos},
+		{ComponentHour, &f.hour, &f.hourPos},
+		{ComponentMinute, &f.minute, &f.minutePos},
+		{ComponentSeconds, &f.seconds, &f.secondsPos},
+	}
+
+	i := 0
+	for n, d := range digits {
+		if d.c == ComponentSeconds && (i >= len(s) || !isDigit(s[i])) {
+			break
+		}
+
+		v, ok := strictDigits(s, i, 2)
+		if !ok {
+			if i+2 > len(s) && n < 3 {
+				return dtgFields{}, newParseError(s, len(s), d.c, ErrNotEnoughChars,
+					"expected two-digit %s", d.c)
+			}
+
+			return dtgFields{}, newParseError(s, i, d.c, ErrInvalidDateTimeGroup,
+				"expected two-digit %s", d.c)
+		}
+
+		*d.v, *d.pos = v, i
+		i += 2
+	}
+
+	f.hasSeconds = i == 8
+
+	// Time zone designation, which is required.
+	f.zonePos = i
+	switch {
+	case i >= len(s) || s[i] == ' ':
+		return dtgFields{}, newParseError(s, i, ComponentZone, ErrInvalidDateTimeGroup,
+			"missing time zone")
+	case s[i] >= 'a' && s[i] <= 'z':
+		return dtgFields{}, newParseError(s, i, ComponentZone, ErrInvalidDateTimeGroup,
+			"time zone %q must be upper case", s[i:i+1])
+	case s[i] < 'A' || s[i] > 'Z':
+		r, _ := utf8.DecodeRuneInString(s[i:])
+		return dtgFields{}, newParseError(s, i, ComponentZone, ErrInvalidDateTimeGroup,
+			"unexpected character %q for time zone", r)
+	}
+
+	zoneEnd := i + 1
+	switch {
+	case strings.HasPrefix(s[zoneEnd:], suffixDoublePrime):
+		zoneEnd += len(suffixDoublePrime)
+	case strings.HasPrefix(s[zoneEnd:], suffixPrime),
+		strings.HasPrefix(s[zoneEnd:], suffixHalfHour),
+		strings.HasPrefix(s[zoneEnd:], suffixThreeQuarterHour):
+		_, size := utf8.DecodeRuneInString(s[zoneEnd:])
+		zoneEnd += size
+	}
+
+	tz, ok := zoneByLetterOrJuliet(rune(s[i]))
+	if zoneEnd > i+1 {
+		tz, ok = zoneByDesignator(rune(s[i]), s[i+1:zoneEnd])
+	}
+
+	if !ok {
+		return dtgFields{}, newParseError(s, i, ComponentZone, ErrInvalidDateTimeGroup,
+			"unknown time zone %q", s[i:zoneEnd])
+	}
+
+	f.tz, f.hasZone = tz, true
+	i = zoneEnd
+
+	if err := p.parseStrictMonthYear(s, i, &f); err != nil {
+		return dtgFields{}, err
+	}
+
+	return f, nil
+}
+
+// parseStrictMonthYear sets the month and year of f from the space,
+// three-letter month, space and two- or four-digit year at offset i in s,
+// which must end there.
+func (p Parser) parseStrictMonthYear(s string, i int, f *dtgFields) error {
+	var ok bool
+
+	// Three-letter month abbreviation.
+	if i, ok = strictSpace(s, i); !ok {
+		return strictSpaceError(s, i, ComponentMonth)
+	}
+
+	monthPos := i
+	for ; i < len(s) && s[i] != ' '; i++ {
+		if s[i] >= 'a' && s[i] <= 'z' {
+			return newParseError(s, monthPos, ComponentMonth, ErrInvalidDateTimeGroup,
+				"month must be upper case")
+		}
+	}
+
+	if i-monthPos != 3 {
+		return newParseError(s, monthPos, ComponentMonth, ErrInvalidMonth,
+			"month %q must be a three-letter abbreviation", s[monthPos:i])
+	}
+
+	m, ok := months[s[monthPos:i]]
+	if !ok {
+		return newParseError(s, monthPos, ComponentMonth, ErrInvalidMonth,
+			"unknown month %q", s[monthPos:i])
+	}
+
+	f.month, f.hasMonth = m, true
+
+	// Two- or four-digit year, which ends the date-time-group.
+	if i, ok = strictSpace(s, i); !ok {
+		return strictSpaceError(s, i, ComponentYear)
+	}
+
+	f.yearPos = i
+	switch len(s) - i {
+	case 2:
+		y, ok := strictDigits(s, i, 2)
+		if !ok {
+			return newParseError(s, i, ComponentYear, ErrInvalidDateTimeGroup,
+				"year %q must be digits", s[i:])
+		}
+
+		f.year = p.expandYear(y, p.reference().UTC().Year())
+	case 4:
+		y, ok := strictDigits(s, i, 4)
+		if !ok {
+			return newParseError(s, i, ComponentYear, ErrInvalidDateTimeGroup,
+				"year %q must be digits", s[i:])
+		}
+
+		f.year = y
+	default:
+		return newParseError(s, i, ComponentYear, ErrInvalidDateTimeGroup,
+			"year %q must have two or four digits", s[i:])
+	}
+
+	f.hasYear = true
+
+	return nil
+}
+
+// strictDigits returns the value of the n digits at offset i in s.
+func strictDigits(s string, i, n int) (int, bool) {
+	if i+n > len(s) {
+		return 0, false
+	}
+
+	v := 0
+	for _, c := range []byte(s[i : i+n]) {
+		if !isDigit(c) {
+			return 0, false
+		}
+
+		v = v*10 + int(c-'0')
+	}
+
+	return v, true
+}
+
+// strictSpace returns the offset following the single space at offset i
+// in s. If there is no single space, it returns the offset of the problem.
+func strictSpace(s string, i int) (int, bool) {
+	if i >= len(s) || s[i] != ' ' {
+		return i, false
+	}
+
+	if i+1 < len(s) && s[i+1] == ' ' {
+		return i + 1, false
+	}
+
+	return i + 1, true
+}
+
+// strictSpaceError returns the error for a missing or repeated space
+// before the component c at offset i in s.
+func strictSpaceError(s string, i int, c Component) error {
+	switch {
+	case i >= len(s):
+		return newParseError(s, i, c, ErrNotEnoughChars, "missing %s", c)
+	case s[i] == ' ':
+		return newParseError(s, i, c, ErrInvalidDateTimeGroup, "expected a single space before the %s", c)
+	default:
+		return newParseError(s, i, c, ErrInvalidDateTimeGroup, "expected a space before the %s", c)
+	}
+}
+
+// isDigit reports whether c is an ASCII digit.
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,199 @@
+package mildtg
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseDTGStrict(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		input     string
+		want      Time
+		offset    int
+		component Component
+		error     error
+	}{
+		{
+			name:  "short year",
+			input: "011200Z JAN 24",
+			want:  NewTime(time.Date(2024, 1, 1, 12, 0, 0, 0, ZULU.Location())),
+		},
+		{
+			name:  "full year",
+			input: "011200R JAN 2024",
+			want:  NewTime(time.Date(2024, 1, 1, 12, 0, 0, 0, ROMEO.Location())),
+		},
+		{
+			name:  "seconds",
+			input: "01120030Z JAN 2024",
+			want:  NewTime(time.Date(2024, 1, 1, 12, 0, 30, 0, ZULU.Location())),
+		},
+		{
+			name:  "suffixed zone",
+			input: "011200E* JAN 2024",
+			want:  NewTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.FixedZone("E*", 5*3600+30*60))),
+		},
+		{
+			name:  "double prime zone",
+			input: "011200M'' JAN 2024",
+			want:  NewTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.FixedZone("M''", 14*3600))),
+		},
+		{
+			name:      "missing zone",
+			input:     "011200 JAN 2024",
+			offset:    6,
+			component: ComponentZone,
+			error:     ErrInvalidDateTimeGroup,
+		},
+		{
+			name:      "lowercase zone",
+			input:     "011200z JAN 2024",
+			offset:    6,
+			component: ComponentZone,
+			error:     ErrInvalidDateTimeGroup,
+		},
+		{
+			name:      "lowercase month",
+			input:     "011200Z Jan 2024",
+			offset:    8,
+			component: ComponentMonth,
+			error:     ErrInvalidDateTimeGroup,
+		},
+		{
+			name:      "no space before month",
+			input:     "011200ZJAN 2024",
+			offset:    7,
+			component: ComponentMonth,
+			error:     ErrInvalidDateTimeGroup,
+		},
+		{
+			name:      "double space before year",
+			input:     "011200Z JAN  2024",
+			offset:    12,
+			component: ComponentYear,
+			error:     ErrInvalidDateTimeGroup,
+		},
+		{
+			name:      "spaces between digits",
+			input:     "01 1200Z JAN 2024",
+			offset:    2,
+			component: ComponentHour,
+			error:     ErrInvalidDateTimeGroup,
+		},
+		{
+			name:      "full month name",
+			input:     "011200Z JANUARY 2024",
+			offset:    8,
+			component: ComponentMonth,
+			error:     ErrInvalidMonth,
+		},
+		{
+			name:      "unknown month",
+			input:     "011200Z JEN 2024",
+			offset:    8,
+			component: ComponentMonth,
+			error:     ErrInvalidMonth,
+		},
+		{
+			name:      "missing year",
+			input:     "011200Z JAN",
+			offset:    11,
+			component: ComponentYear,
+			error:     ErrNotEnoughChars,
+		},
+		{
+			name:      "three-digit year",
+			input:     "011200Z JAN 202",
+			offset:    12,
+			component: ComponentYear,
+			error:     ErrInvalidDateTimeGroup,
+		},
+		{
+			name:      "trailing text",
+			input:     "011200Z JAN 2024Z",
+			offset:    12,
+			component: ComponentYear,
+			error:     ErrInvalidDateTimeGroup,
+		},
+		{
+			name:      "one-digit seconds",
+			input:     "0112003Z JAN 2024",
+			offset:    6,
+			component: ComponentSeconds,
+			error:     ErrInvalidDateTimeGroup,
+		},
+		{
+			name:      "too short",
+			input:     "0112",
+			offset:    4,
+			component: ComponentMinute,
+			error:     ErrNotEnoughChars,
+		},
+		{
+			name:      "hour out of range",
+			input:     "012500Z JAN 2024",
+			offset:    2,
+			component: ComponentHour,
+			error:     ErrInvalidDateTimeGroup,
+		},
+		{
+			name:      "day out of range",
+			input:     "300000Z FEB 2024",
+			offset:    0,
+			component: ComponentDay,
+			error:     ErrInvalidDay,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDTGStrict(tt.input)
+			if !errors.Is(err, tt.error) {
+				t.Fatalf("got %v, want %v", err, tt.error)
+			}
+
+			if err != nil {
+				var pe *ParseError
+				if !errors.As(err, &pe) {
+					t.Fatalf("got %T, want *ParseError", err)
+				}
+
+				if pe.Offset != tt.offset || pe.Component != tt.component {
+					t.Errorf("got %v at %d, want %v at %d", pe.Component, pe.Offset, tt.component, tt.offset)
+				}
+
+				return
+			}
+
+			if !got.Equal(tt.want.Time) || got.String() != tt.want.String() {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDTGStrict_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, tz := range append(AllZones(), ExtendedZones()...) {
+		in := NewTime(time.Date(2024, 2, 29, 23, 59, 1, 0, tz.Location()))
+
+		for _, layout := range []string{MILDTGFULLYEAR, MILDTGSHORTYEAR} {
+			s := in.Format(layout)
+
+			got, err := ParseDTGStrict(s)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", s, err)
+				continue
+			}
+
+			if !got.Equal(in.Time) {
+				t.Errorf("%s: got %v, want %v", s, got, in)
+			}
+		}
+	}
+}
@@ -1,11 +1,10 @@
 package mildtg
 
 import (
-	"bytes"
 	"errors"
-	"fmt"
 	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 const (
@@ -28,6 +27,22 @@ var (
 
 	// ErrInvalidDateTimeGroup is returned when an invalid date-time-group is provided.
 	ErrInvalidDateTimeGroup = errors.New("invalid date-time-group")
+
+	// ErrYearOutOfRange is returned when a year is outside the bounds
+	// of a Parser or Formatter, which default to 1941 through 9999.
+	ErrYearOutOfRange = errors.New("year out of range")
+
+	// ErrNonexistentLocalTime is returned when a Juliet time falls in the gap
+	// skipped when local clocks move forward.
+	ErrNonexistentLocalTime = errors.New("local time does not exist")
+
+	// ErrAmbiguousLocalTime is returned when a Juliet time occurs twice
+	// because local clocks move back.
+	ErrAmbiguousLocalTime = errors.New("local time is ambiguous")
+
+	// ErrInvalidParser is returned when a Parser has a CenturyWindow
+	// outside 0 through 99 or a MinYear after its MaxYear.
+	ErrInvalidParser = errors.New("invalid parser")
 )
 
 // Time wraps a time.Time to allow for custom
@@ -38,83 +53,71 @@ type Time struct {
 }
 
 // Format returns the date-time-group in the format
+// using the zero Formatter. Times whose offset has no
+// military time zone letter are written in Zulu, and
+// years the Formatter rejects are written as "INVALID DTG".
+//
+// Besides MILDTGFULLYEAR and MILDTGSHORTYEAR, a layout may be written
+// with the following tokens. Tokens are recognized anywhere outside
+// quoted text, even inside words, so words such as "ISSUED" or "ZULU"
+// must be quoted. Text in single quotes is copied as is, and two single
+// quotes write one. Any other text in the layout is copied as is.
+//
+//	DD     two-digit day of the month
+//	DDD    three-digit day of the year, as in military Julian dates
+//	hh     two-digit hour (00-23)
+//	kk     two-digit hour, writing midnight as 2400 of the previous day
+//	mm     two-digit minute
+//	ss     two-digit seconds
+//	[ss]   two-digit seconds, written only when they are not zero
+//	Z      time zone designation, such as "Z", "R" or "E*"
+//	ZONE   phonetic time zone name, such as "ZULU" or "ROMEO"
+//	MMM    three-letter month abbreviation, such as "JAN"
+//	MMMM   full month name, such as "JANUARY"
+//	YY     two-digit year
+//	YYYY   four-digit year
+//	YDDD   last digit of the year and day of the year, as in military
+//	       Julian dates
+//
+// For example, "DDhhmmZ MMM YY" writes "011200Z JAN 24" and
+// "DD hhmmZ MMMM YYYY" writes "01 1200Z JANUARY 2024". Deadlines at
+// midnight may be written with kk, as in "NLT DDkkmmZ MMM YY" writing
+// "NLT 312400Z DEC 24" for midnight on January 1, 2025. Military Julian
+// dates are written with "YDDD", "YYDDD" or "YYYYDDD", as in "4015" for
+// January 15, 2024; a Y not followed by DDD is copied as is. Literal
+// words are quoted, as in "'ISSUED' DDhhmmZ MMM YY '(ZULU)'" writing
+// "ISSUED 011200Z JAN 24 (ZULU)".
+// MILDTGFULLYEAR is equivalent to "DDhhmm[ss]Z MMM YYYY" and
+// MILDTGSHORTYEAR to "DDhhmm[ss]Z MMM YY". A layout that uses none of
+// the DD, hh, kk, MMM or YY tokens outside quoted text is passed to
+// time.Time.Format.
 func (t Time) Format(layout string) string {
-	switch layout {
-	case MILDTGFULLYEAR:
-		return t.toString(true)
-	case MILDTGSHORTYEAR:
-		return t.toString(false)
-	default:
-		return t.Time.Format(layout)
-	}
-}
-
-// String returns the date-time-group in the format
-func (t Time) String() string {
-	return t.toString(false)
-}
-
-// toString returns the date-time-group in the format
-// with a long year or a short year.
-func (t Time) toString(longYear bool) string {
-	if t.IsZero() {
+	s, err := Formatter{}.Format(t, layout)
+	if err != nil {
 		return invalidDTG
 	}
 
-	days := t.Day()
-	hours := t.Hour()
-	minutes := t.Minute()
-	seconds := t.Second()
-	month := t.Month()
-	year := t.Year()
-	tz := t.Location().String()
-
-	b := bytes.NewBuffer(make([]byte, 0, 30))
-
-	// Day
-	if days < 10 {
-		b.WriteString("0")
-	}
-	b.WriteString(fmt.Sprintf("%d", days))
-
-	// Hour
-	if hours < 10 {
-		b.WriteString("0")
-	}
-	b.WriteString(fmt.Sprintf("%d", hours))
-
-	// Minute
-	if minutes < 10 {
-		b.WriteString("0")
-	}
-	b.WriteString(fmt.Sprintf("%d", minutes))
+	return s
+}
 
-	// Only export seconds if they are not zero
-	if seconds != 0 {
-		if seconds < 10 {
-			b.WriteString("0")
-		}
-		b.WriteString(fmt.Sprintf("%d", seconds))
+// AppendFormat is like Format but appends the textual representation
+// to dst and returns the extended buffer. It does not allocate when dst
+// has enough capacity.
+func (t Time) AppendFormat(dst []byte, layout string) []byte {
+	b, err := Formatter{}.AppendFormat(dst, t, layout)
+	if err != nil {
+		return append(dst, invalidDTG...)
 	}
 
-	// Timezone
-	b.WriteString(tz)
-
-	b.WriteString(" ")
-
-	// Month
-	b.WriteString(strings.ToUpper(month.String()[0:3]))
-
-	b.WriteString(" ")
-
-	// Year
-	if longYear {
-		b.WriteString(fmt.Sprintf("%d", year))
-	} else {
-		b.WriteString(fmt.Sprintf("%d", year%100))
-	}
+	return b
+}
 
-	return b.String()
+// String returns the date-time-group in the MILDTGSHORTYEAR layout using
+// the zero Formatter, so years outside 1969 through 2068 are written as
+// "INVALID DTG" even if a Parser with a CenturyWindow read them. Use a
+// Formatter with the parser's CenturyWindow and Reference to write them.
+func (t Time) String() string {
+	return t.Format(MILDTGSHORTYEAR)
 }
 
 // NewTime returns a new Time object.
@@ -124,71 +127,111 @@ func NewTime(t time.Time) Time {
 
 // ParseDTG parses a military date-time-group string in the format
 // DDHH[MM]|[MMSS]|(A-Z)[ MMM YY[YY] and returns a Time object.
+// The time zone letter may carry a designation suffix such as "E*"
+// or "M'" (see ExtendedZones). Juliet (J) times are in time.Local.
+// The time 2400 is midnight at the end of the given day, so "312400Z DEC 24"
+// is midnight on January 1, 2025.
+//
+// A missing month or year is inferred as the date closest to the current
+// time, and years before 1941 or after 9999 are rejected with
+// ErrYearOutOfRange. ParseDTG is equivalent to Parser{}.Parse(s).
 func ParseDTG(s string) (Time, error) {
-	return parseDTGBytes(s)
+	return Parser{}.Parse(s)
 }
 
-// ParseDTGBytes parses a military date-time-group byte slice in the format
-// DDHH[MM]|[MMSS]|(A-Z)[ MMM YY[YY] and returns a Time object.
-func parseDTGBytes(s string) (Time, error) {
+// ParseDTGInLocation is like ParseDTG but interprets Juliet (J) times
+// as local times in loc, using the offset in effect on the parsed date.
+func ParseDTGInLocation(s string, loc *time.Location) (Time, error) {
+	if loc == nil {
+		panic("mildtg: nil location in ParseDTGInLocation")
+	}
 
-	// The digitsBeforeChar slice is used to store the digits before any
-	// characters in the date-time-group.
-	// The slice has enough capacity to store the maximum number of digits
+	return Parser{Juliet: loc}.Parse(s)
+}
+
+// ParseDTGBytes is like ParseDTG but parses a byte slice without
+// allocating. The slice is not retained.
+//
+// ParseDTGBytes is equivalent to Parser{}.ParseBytes(b).
+func ParseDTGBytes(b []byte) (Time, error) {
+	return Parser{}.ParseBytes(b)
+}
+
+// parseDTGBytes parses a military date-time-group in the format
+// DDHH[MM]|[MMSS]|(A-Z)[ MMM YY[YY] without allocating and returns the
+// fields it contains.
+func (p Parser) parseDTGBytes(s string) (dtgFields, error) {
+
+	// The digitsBeforeChar array is used to store the digits before any
+	// characters in the date-time-group, and digitsBeforePos stores the
+	// offset of each digit in s.
+	// The array has enough capacity to store the maximum number of digits
 	// before a character in the date-time-group.
-	// If there are no characters in the date-time-group, the slice will
+	// If there are no characters in the date-time-group, the array will
 	// store the maximum number of digits.
-	digitsBeforeChar := make([]byte, 0, 2*4+4)
-	maxDigitsBeforeChar := 2*4 + 4
+	var digitsBeforeChar [2*4 + 4]byte
+	var digitsBeforePos [2*4 + 4]int
 
-	// The digitsAfterChar slice is used to store the digits after any
+	// The digitsAfterChar array is used to store the digits after any
 	// characters in the date-time-group.
-	// This slice will have enough capacity to store a four-digit year.
-	digitsAfterChar := make([]byte, 0, 4)
-	maxDigitsAfterChar := 4
+	// This array will have enough capacity to store a four-digit year.
+	var digitsAfterChar [4]byte
+	digitsAfterPos := 0
 
 	// September is the longest month name plus one for the time zone designation.
-	chars := make([]byte, 0, 9+1)
-	maxChars := 9 + 1
+	// The charsPos array stores the offset of each character in s.
+	var chars [9 + 1]byte
+	var charsPos [9 + 1]int
 
-	// The index is used to keep track of the current index in the digits slice.
+	// The index is used to keep track of the current index in each array.
 	digitsBeforeIndex := 0
 	digitsAfterIndex := 0
 	charIndex := 0
-	charsFound := false
+
+	// The suffix holds the designation suffix following the time zone
+	// letter of a zone that is not a whole number of hours from UTC.
+	suffix := ""
 
 	// Iterate over each byte in the byte slice.
 	for i := 0; i < len(s); i++ {
 		switch {
 		case s[i] >= '0' && s[i] <= '9':
 			// Digit.
-			if !charsFound {
-				// If the index is greater than or equal to the length of the slice,
+			if charIndex == 0 {
+				// If the index is greater than or equal to the length of the array,
 				// return an error.
-				if digitsBeforeIndex >= maxDigitsBeforeChar {
-					return Time{}, ErrInvalidDateTimeGroup
+				if digitsBeforeIndex >= len(digitsBeforeChar) {
+					return dtgFields{}, newParseError(s, i, ComponentNone, ErrInvalidDateTimeGroup,
+						"too many digits before the time zone or month")
 				}
 
-				digitsBeforeChar = append(digitsBeforeChar, s[i])
+				digitsBeforeChar[digitsBeforeIndex] = s[i]
+				digitsBeforePos[digitsBeforeIndex] = i
 				digitsBeforeIndex++
 
 			} else {
-				// If the index is greater than or equal to the length of the slice,
+				// If the index is greater than or equal to the length of the array,
 				// return an error.
 				// This could happen if the method receives a year with more than
 				// four digits.
-				if digitsAfterIndex >= maxDigitsAfterChar {
-					return Time{}, ErrInvalidDateTimeGroup
+				if digitsAfterIndex >= len(digitsAfterChar) {
+					return dtgFields{}, newParseError(s, digitsAfterPos, ComponentYear, ErrInvalidDateTimeGroup,
+						"year has more than four digits")
+				}
+
+				if digitsAfterIndex == 0 {
+					digitsAfterPos = i
 				}
 
-				digitsAfterChar = append(digitsAfterChar, s[i])
+				digitsAfterChar[digitsAfterIndex] = s[i]
 				digitsAfterIndex++
 			}
 
 		case s[i] >= 'A' && s[i] <= 'Z' || s[i] >= 'a' && s[i] <= 'z':
 			// Character.
-			if charIndex >= maxChars {
-				return Time{}, ErrInvalidDateTimeGroup
+			if charIndex >= len(chars) {
+				return dtgFields{}, newParseError(s, charsPos[0], ComponentMonth, ErrInvalidDateTimeGroup,
+					"too many letters for a time zone and month")
 			}
 
 			var char byte
@@ -198,11 +241,32 @@ func parseDTGBytes(s string) (Time, error) {
 				char = s[i]
 			}
 
-			chars = append(chars, char)
+			chars[charIndex] = char
+			charsPos[charIndex] = i
 			charIndex++
 
-			// Set the charsFound flag to true.
-			charsFound = true
+		case s[i] == '*' || s[i] == '\'' || strings.HasPrefix(s[i:], suffixThreeQuarterHour):
+			// Time zone designation suffix.
+			// A suffix may only follow the time zone letter, and a second
+			// prime mark may only follow the first.
+			if charIndex != 1 || digitsAfterIndex != 0 ||
+				suffix != "" && (suffix != suffixPrime || s[i] != '\'') {
+				r, _ := utf8.DecodeRuneInString(s[i:])
+				return dtgFields{}, newParseError(s, i, ComponentZone, ErrInvalidDateTimeGroup,
+					"unexpected designation suffix %q", r)
+			}
+
+			switch {
+			case s[i] == '*':
+				suffix = suffixHalfHour
+			case s[i] == '\'' && suffix == suffixPrime:
+				suffix = suffixDoublePrime
+			case s[i] == '\'':
+				suffix = suffixPrime
+			default:
+				suffix = suffixThreeQuarterHour
+				i += len(suffixThreeQuarterHour) - 1
+			}
 
 		case s[i] == ' ':
 			// Do nothing.
@@ -210,161 +274,331 @@ func parseDTGBytes(s string) (Time, error) {
 
 		default:
 			// Invalid character.
-			return Time{}, ErrInvalidDateTimeGroup
+			r, _ := utf8.DecodeRuneInString(s[i:])
+			return dtgFields{}, newParseError(s, i, ComponentNone, ErrInvalidDateTimeGroup,
+				"unexpected character %q", r)
 		}
 	}
 
-	// The digitsBeforeChar slice must have at least six digits
-	// and have a zero remainder if len(digitsBeforeChar) is divided by 2.
-	if len(digitsBeforeChar) < 6 || len(digitsBeforeChar)%2 != 0 {
-		return Time{}, ErrNotEnoughChars
-	}
+	// The digitsBeforeChar array must have at least six digits
+	// and have a zero remainder if the digit count is divided by 2.
+	if digitsBeforeIndex < 6 || digitsBeforeIndex%2 != 0 {
+		offset := 0
+		if digitsBeforeIndex > 0 {
+			offset = digitsBeforePos[digitsBeforeIndex-1] + 1
+		}
 
-	// The day, hour, and minute are extracted from the digitsBeforeChar slice.
-	day := int(digitsBeforeChar[0]-'0')*10 + int(digitsBeforeChar[1]-'0')
-	hour := int(digitsBeforeChar[2]-'0')*10 + int(digitsBeforeChar[3]-'0')
-	minute := int(digitsBeforeChar[4]-'0')*10 + int(digitsBeforeChar[5]-'0')
-	seconds := 0
-	year := time.Now().UTC().Year()
-	month := time.Now().UTC().Month()
-	tz := ZULU
+		return dtgFields{}, newParseError(s, offset, ComponentNone, ErrNotEnoughChars,
+			"expected DDHHMM followed by pairs of digits, found %d digits", digitsBeforeIndex)
+	}
 
-	// Remove the day, hour, and minute from the slice.
-	digitsBeforeChar = digitsBeforeChar[6:]
+	// The day, hour, and minute are extracted from the digitsBeforeChar array.
+	f := dtgFields{
+		day:       int(digitsBeforeChar[0]-'0')*10 + int(digitsBeforeChar[1]-'0'),
+		hour:      int(digitsBeforeChar[2]-'0')*10 + int(digitsBeforeChar[3]-'0'),
+		minute:    int(digitsBeforeChar[4]-'0')*10 + int(digitsBeforeChar[5]-'0'),
+		dayPos:    digitsBeforePos[0],
+		hourPos:   digitsBeforePos[2],
+		minutePos: digitsBeforePos[4],
+	}
 
-	switch {
-	case len(digitsBeforeChar) == 0:
+	// The remaining digits before the character follow the day, hour, and minute.
+	switch digitsBeforeIndex - 6 {
+	case 0:
 		// Do nothing.
-	case len(digitsBeforeChar) == 2:
+	case 2:
 		// If the length of the remaining digits before the character is two, we
 		// can assume these two digits represent the seconds.
-		seconds = int(digitsBeforeChar[0]-'0')*10 + int(digitsBeforeChar[1]-'0')
-		if seconds > 59 {
-			return Time{}, ErrInvalidDateTimeGroup
-		}
-	case len(digitsBeforeChar) == 4:
+		f.seconds = int(digitsBeforeChar[6]-'0')*10 + int(digitsBeforeChar[7]-'0')
+		f.secondsPos = digitsBeforePos[6]
+		f.hasSeconds = true
+	case 4:
 		// If the length of the remaining digits before the character is four, we
 		// can assume these four digits represent the four-digit year.
 		// We do not attempt to parse a two-digit second with a two-digit year.
-		year = int(digitsBeforeChar[0]-'0')*1000 + int(digitsBeforeChar[1]-'0')*100 +
-			int(digitsBeforeChar[2]-'0')*10 + int(digitsBeforeChar[3]-'0')
+		f.year = int(digitsBeforeChar[6]-'0')*1000 + int(digitsBeforeChar[7]-'0')*100 +
+			int(digitsBeforeChar[8]-'0')*10 + int(digitsBeforeChar[9]-'0')
+		f.yearPos = digitsBeforePos[6]
+		f.hasYear = true
 
-	case len(digitsBeforeChar) == 6:
+	case 6:
 		// If the length of the remaining digits before the character is six, we
 		// can assume we have a two-digit seconds and a four-digit year.
-		seconds = int(digitsBeforeChar[0]-'0')*10 + int(digitsBeforeChar[1]-'0')
-		if seconds > 59 {
-			return Time{}, ErrInvalidDateTimeGroup
-		}
+		f.seconds = int(digitsBeforeChar[6]-'0')*10 + int(digitsBeforeChar[7]-'0')
+		f.secondsPos = digitsBeforePos[6]
+		f.hasSeconds = true
+		f.year = int(digitsBeforeChar[8]-'0')*1000 + int(digitsBeforeChar[9]-'0')*100 +
+			int(digitsBeforeChar[10]-'0')*10 + int(digitsBeforeChar[11]-'0')
+		f.yearPos = digitsBeforePos[8]
+		f.hasYear = true
+	}
 
-		year = int(digitsBeforeChar[2]-'0')*1000 + int(digitsBeforeChar[3]-'0')*100 +
-			int(digitsBeforeChar[4]-'0')*10 + int(digitsBeforeChar[5]-'0')
+	// Parse the month and time zone from the chars array.
+	letters := chars[:charIndex]
+	if charIndex > 0 {
+		f.zonePos = charsPos[0]
 	}
 
-	// Parse the month and time zone from the chars slice.
 	switch {
-	case len(chars) == 0:
+	case suffix != "":
+		// If a designation suffix was found, the first character is the time
+		// zone letter and any remaining characters must be the month.
+		tzOut, ok := zoneByDesignator(rune(letters[0]), suffix)
+		if !ok {
+			return dtgFields{}, newParseError(s, f.zonePos, ComponentZone, ErrInvalidDateTimeGroup,
+				"unknown time zone %q", string(letters[0])+suffix)
+		}
+
+		if len(letters) > 1 {
+			m, ok := months[string(letters[1:])]
+			if !ok {
+				return dtgFields{}, newParseError(s, charsPos[1], ComponentMonth, ErrInvalidMonth,
+					"unknown month %q", string(letters[1:]))
+			}
+
+			f.month = m
+			f.hasMonth = true
+		}
+
+		f.tz = tzOut
+		f.hasZone = true
+	case len(letters) == 0:
 		// Do nothing.
-	case len(chars) == 1:
-		// If the length of the chars slice is one, we can assume this character
+	case len(letters) == 1:
+		// If the length of the chars array is one, we can assume this character
 		// represents the time zone.
-		tzStr := strings.ToUpper(string(chars[0]))
-		tzOut, ok := timeZones[rune(tzStr[0])]
+		tzOut, ok := zoneByLetterOrJuliet(rune(letters[0]))
 		if !ok {
-			// Get the local offset.
-			offset := time.Now().UTC().Sub(time.Now()).Seconds() / 3600
-			tzOut = timeZone{letter: rune(tzStr[0]), offset: int32(offset)}
+			return dtgFields{}, newParseError(s, f.zonePos, ComponentZone, ErrInvalidDateTimeGroup,
+				"unknown time zone %q", string(letters))
 		}
 
-		tz = tzOut
-	case len(chars) == 3:
-		// If the length of the chars slice is three, we can assume this represents
+		f.tz = tzOut
+		f.hasZone = true
+	case len(letters) == 3:
+		// If the length of the chars array is three, we can assume this represents
 		// the three-letter month abbreviation.
-		monthStr := string(chars)
-		monthOut, ok := months[strings.ToUpper(monthStr)]
+		monthOut, ok := months[string(letters)]
 		if !ok {
-			return Time{}, ErrInvalidMonth
+			return dtgFields{}, newParseError(s, charsPos[0], ComponentMonth, ErrInvalidMonth,
+				"unknown month %q", string(letters))
 		}
 
-		month = monthOut
-	case len(chars) > 3:
-		// If the length of the chars slice is greater than three, we either have a
+		f.month = monthOut
+		f.hasMonth = true
+	case len(letters) > 3:
+		// If the length of the chars array is greater than three, we either have a
 		// time zone, a three-letter month abbreviation, or a full month name or a
 		// combination of these.
 		//
 		// Check if the char string contains a month.
-		m, ok := months[strings.ToUpper(string(chars))]
+		m, ok := months[string(letters)]
 		if !ok {
-			// Remove the first character from the chars slice.
-			tzStr := strings.ToUpper(string(chars[0]))
-			newMonthStr := string(chars[1:])
-
-			// Check if the new month string is a valid month.
-			m, ok = months[strings.ToUpper(newMonthStr)]
+			// Check if the string without the first character is a valid month.
+			m, ok = months[string(letters[1:])]
 			if !ok {
-				return Time{}, ErrInvalidMonth
+				return dtgFields{}, newParseError(s, charsPos[1], ComponentMonth, ErrInvalidMonth,
+					"unknown month %q", string(letters[1:]))
 			}
 
-			tzOut, tzFound := timeZones[rune(tzStr[0])]
+			tzOut, tzFound := zoneByLetterOrJuliet(rune(letters[0]))
 			if !tzFound {
-				// Get the local offset.
-				offset := time.Now().UTC().Sub(time.Now()).Seconds() / 3600
-				tzOut = timeZone{letter: rune(tzStr[0]), offset: int32(offset)}
+				return dtgFields{}, newParseError(s, f.zonePos, ComponentZone, ErrInvalidDateTimeGroup,
+					"unknown time zone %q", string(letters[:1]))
 			}
 
-			tz = tzOut
-			month = m
+			f.tz = tzOut
+			f.hasZone = true
 		}
 
-		month = m
+		f.month = m
+		f.hasMonth = true
 
 	default:
-		return Time{}, ErrInvalidDateTimeGroup
-	}
-
-	// Check the digitsAfterChar slice.
-	if len(digitsAfterChar)%2 != 0 {
-		return Time{}, ErrInvalidDateTimeGroup
+		return dtgFields{}, newParseError(s, f.zonePos, ComponentZone, ErrInvalidDateTimeGroup,
+			"unknown time zone or month %q", string(letters))
 	}
 
-	// The maximum length of the digitsAfterChar slice is four,
+	// The maximum length of the digitsAfterChar array is four,
 	// and we should not see 1 or 3 digits.
-	switch len(digitsAfterChar) {
+	switch digitsAfterIndex {
 	case 0:
 		// Do nothing.
 	case 2:
 		// Two-digit year.
 		y := int(digitsAfterChar[0]-'0')*10 + int(digitsAfterChar[1]-'0')
 
-		// Determine if the year is in the 21st or 20th century.
-		if y < 69 {
-			year = 2000 + y
-		} else {
-			year = 1900 + y
-		}
+		f.year = p.expandYear(y, p.reference().UTC().Year())
+		f.yearPos = digitsAfterPos
+		f.hasYear = true
 	case 4:
 		// Four-digit year.
-		y := int(digitsAfterChar[0]-'0')*1000 + int(digitsAfterChar[1]-'0')*100 +
+		f.year = int(digitsAfterChar[0]-'0')*1000 + int(digitsAfterChar[1]-'0')*100 +
 			int(digitsAfterChar[2]-'0')*10 + int(digitsAfterChar[3]-'0')
+		f.yearPos = digitsAfterPos
+		f.hasYear = true
+	default:
+		return dtgFields{}, newParseError(s, digitsAfterPos, ComponentYear, ErrInvalidDateTimeGroup,
+			"year %q must have two or four digits", string(digitsAfterChar[:digitsAfterIndex]))
+	}
+
+	return f, nil
+}
+
+// dtgFields holds the fields of a date-time-group and the byte offset
+// in the input where each was found.
+type dtgFields struct {
+	day, hour, minute, seconds int
+	month                      time.Month
+	year                       int
+	tz                         TimeZone
+
+	// Which of the optional fields were given.
+	hasSeconds, hasZone, hasMonth, hasYear bool
+
+	dayPos, hourPos, minutePos, secondsPos, zonePos, yearPos int
+}
+
+// resolve validates the fields parsed from s, infers a missing month or
+// year, and returns the resulting Time.
+func (p Parser) resolve(s string, f dtgFields) (Time, error) {
+	if err := checkClock(s, f); err != nil {
+		return Time{}, err
+	}
+
+	if !f.hasZone {
+		f.tz = p.defaultZone()
+	}
+
+	loc := p.location(f.tz)
+
+	if !f.hasMonth || !f.hasYear {
+		y, m, found, validDay := p.inferDate(f.year, f.month, f.day, f.hour, f.minute, f.seconds,
+			loc, f.hasMonth, f.hasYear)
+		switch {
+		case !validDay:
+			return Time{}, newParseError(s, f.dayPos, ComponentDay, ErrInvalidDay,
+				"day \"%02d\" does not exist in any candidate month", f.day)
+		case !found:
+			// Report the missing component at the end of the input.
+			c := ComponentMonth
+			if f.hasMonth {
+				c = ComponentYear
+			}
+
+			switch p.Bias {
+			case BiasPast:
+				return Time{}, newParseError(s, len(s), c, ErrInvalidDateTimeGroup,
+					"no %s gives a date at or before the reference time", c)
+			case BiasFuture:
+				return Time{}, newParseError(s, len(s), c, ErrInvalidDateTimeGroup,
+					"no %s gives a date at or after the reference time", c)
+			default:
+				return Time{}, newParseError(s, len(s), c, ErrInvalidDateTimeGroup,
+					"no %s gives a date within the year bounds", c)
+			}
+		}
+
+		f.year, f.month = y, m
+	}
 
-		year = y
+	if !p.yearInRange(f.year) {
+		lo, hi := p.yearBounds()
+		return Time{}, newParseError(s, f.yearPos, ComponentYear, ErrYearOutOfRange,
+			"year %d out of range %d to %d", f.year, lo, hi)
 	}
 
 	// Check if the day is valid for the month and year.
-	if day > daysInMonth(month, year) || day < 1 {
-		return Time{}, ErrInvalidDay
+	if f.day > daysInMonth(f.month, f.year) || f.day < 1 {
+		return Time{}, newParseError(s, f.dayPos, ComponentDay, ErrInvalidDay,
+			"day \"%02d\" out of range for %s %d", f.day, f.month, f.year)
 	}
 
-	// Check hours and minutes.
-	if hour > 23 || minute > 59 {
-		return Time{}, ErrInvalidDateTimeGroup
+	if f.hour == 24 {
+		f.year, f.month, f.day = time.Date(f.year, f.month, f.day+1, 0, 0, 0, 0, time.UTC).Date()
+		f.hour = 0
 	}
 
-	t := time.Date(year, month, day, hour, minute, seconds, 0, tz.Location())
+	if f.tz == JULIET {
+		t, err := localDate(f.year, f.month, f.day, f.hour, f.minute, f.seconds, loc)
+		if err != nil {
+			return Time{}, newParseError(s, f.zonePos, ComponentZone, err,
+				"%v in %s", err, loc)
+		}
+
+		return NewTime(t), nil
+	}
+
+	t := time.Date(f.year, f.month, f.day, f.hour, f.minute, f.seconds, 0, loc)
 
 	return NewTime(t), nil
 }
 
+// checkClock validates the hours, minutes and seconds parsed from s.
+func checkClock(s string, f dtgFields) error {
+	if f.hour > 24 {
+		return newParseError(s, f.hourPos, ComponentHour, ErrInvalidDateTimeGroup,
+			"hour \"%02d\" out of range", f.hour)
+	}
+
+	// 2400 is midnight at the end of the day, and the only time with hour 24.
+	if f.hour == 24 && (f.minute != 0 || f.seconds != 0) {
+		return newParseError(s, f.hourPos, ComponentHour, ErrInvalidDateTimeGroup,
+			"hour \"24\" is only valid as 2400")
+	}
+
+	if f.minute > 59 {
+		return newParseError(s, f.minutePos, ComponentMinute, ErrInvalidDateTimeGroup,
+			"minute \"%02d\" out of range", f.minute)
+	}
+
+	if f.seconds > 59 {
+		return newParseError(s, f.secondsPos, ComponentSeconds, ErrInvalidDateTimeGroup,
+			"seconds \"%02d\" out of range", f.seconds)
+	}
+
+	return nil
+}
+
+// localDate returns the time for the wall clock in loc, which must exist
+// exactly once on the given date. A wall clock skipped when clocks move
+// forward returns ErrNonexistentLocalTime, and one repeated when clocks
+// move back returns ErrAmbiguousLocalTime.
+func localDate(year int, month time.Month, day, hour, minute, seconds int, loc *time.Location) (time.Time, error) {
+	t := time.Date(year, month, day, hour, minute, seconds, 0, loc)
+
+	// time.Date normalizes a skipped wall clock into the next offset,
+	// so the fields no longer match the ones requested.
+	if !sameWallClock(t, year, month, day, hour, minute, seconds) {
+		return time.Time{}, ErrNonexistentLocalTime
+	}
+
+	// The wall clock is ambiguous if shifting the instant by the change
+	// in offset of a nearby transition yields the same wall clock.
+	_, offset := t.Zone()
+	for _, probe := range []time.Duration{-12 * time.Hour, 12 * time.Hour} {
+		_, other := t.Add(probe).Zone()
+		if other == offset {
+			continue
+		}
+
+		alt := t.Add(time.Duration(offset-other) * time.Second)
+		if sameWallClock(alt, year, month, day, hour, minute, seconds) {
+			return time.Time{}, ErrAmbiguousLocalTime
+		}
+	}
+
+	return t, nil
+}
+
+// sameWallClock reports whether t has the given date and clock fields.
+func sameWallClock(t time.Time, year int, month time.Month, day, hour, minute, seconds int) bool {
+	y, m, d := t.Date()
+	h, mi, s := t.Clock()
+
+	return y == year && m == month && d == day && h == hour && mi == minute && s == seconds
+}
+
 // removeSpaces removes all spaces from a string.
 func removeSpaces(s string) string {
 	return strings.ReplaceAll(s, " ", "")
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"testing"
 	"time"
+
+	"github.com/Type3Solutions/mildtg/mildtgtest"
 )
 
 func TestTime_Format(t *testing.T) {
@@ -78,9 +80,78 @@ func TestTime_String(t *testing.T) {
 	}
 }
 
+func TestTime_AppendFormat(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		input  Time
+		layout string
+		want   string
+	}{
+		{
+			name:   "full year",
+			input:  NewTime(time.Date(2021, 1, 1, 1, 0, 59, 0, ROMEO.Location())),
+			layout: MILDTGFULLYEAR,
+			want:   "at 01010059R JAN 2021",
+		},
+		{
+			name:   "time layout",
+			input:  NewTime(time.Date(2021, 1, 1, 1, 0, 0, 0, ZULU.Location())),
+			layout: "2006-01-02",
+			want:   "at 2021-01-01",
+		},
+		{
+			name:   "invalid time",
+			input:  Time{},
+			layout: MILDTGSHORTYEAR,
+			want:   "at " + invalidDTG,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(tt.input.AppendFormat([]byte("at "), tt.layout)); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDTGBytes(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseDTGBytes([]byte("01010059R JAN 2021"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := time.Date(2021, 1, 1, 1, 0, 59, 0, ROMEO.Location())
+	if !got.Equal(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	// The input reported in an error must not change with the slice.
+	in := []byte("012500Z JAN 2021")
+	_, err = ParseDTGBytes(in)
+
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		t.Fatalf("got %T, want *ParseError", err)
+	}
+
+	copy(in, "XXXXXXXXXXXXXXXX")
+	if pe.Input != "012500Z JAN 2021" {
+		t.Errorf("got %v, want %v", pe.Input, "012500Z JAN 2021")
+	}
+}
+
 func TestParseDTG(t *testing.T) {
 	t.Parallel()
 
+	// Missing months and years are inferred from the clock.
+	p := Parser{Clock: mildtgtest.NewClock(time.Date(2024, 3, 10, 15, 0, 0, 0, time.UTC))}
+
 	tests := []struct {
 		name  string
 		input string
@@ -120,8 +191,7 @@ func TestParseDTG(t *testing.T) {
 		{
 			name:  "day, hour, and minute only",
 			input: "010100",
-			want: NewTime(time.Date(time.Now().UTC().Year(),
-				time.Now().UTC().Month(), 1, 1, 0, 0, 0, ZULU.Location())),
+			want:  NewTime(time.Date(2024, 3, 1, 1, 0, 0, 0, ZULU.Location())),
 			error: nil,
 		},
 		{
@@ -187,8 +257,8 @@ func TestParseDTG(t *testing.T) {
 		{
 			name:  "invalid year",
 			input: "010100ZJAN1940",
-			want:  NewTime(time.Date(1940, 1, 1, 1, 0, 0, 0, ZULU.Location())),
-			error: nil,
+			want:  Time{},
+			error: ErrYearOutOfRange,
 		},
 		{
 			name:  "invalid day for month",
@@ -289,22 +359,19 @@ func TestParseDTG(t *testing.T) {
 		{
 			name:  "digits only with seconds",
 			input: "01010159",
-			want: NewTime(time.Date(time.Now().UTC().Year(),
-				time.Now().UTC().Month(), 1, 1, 1, 59, 0, ZULU.Location())),
+			want:  NewTime(time.Date(2024, 3, 1, 1, 1, 59, 0, ZULU.Location())),
 			error: nil,
 		},
 		{
 			name:  "digits and full four-digit year",
 			input: "010101592021",
-			want: NewTime(time.Date(2021, time.Now().UTC().Month(),
-				1, 1, 1, 59, 0, ZULU.Location())),
+			want:  NewTime(time.Date(2021, time.December, 1, 1, 1, 59, 0, ZULU.Location())),
 			error: nil,
 		},
 		{
 			name:  "time with time zone",
 			input: "010100R",
-			want: NewTime(time.Date(time.Now().UTC().Year(),
-				time.Now().UTC().Month(), 1, 1, 0, 0, 0, ROMEO.Location())),
+			want:  NewTime(time.Date(2024, 3, 1, 1, 0, 0, 0, ROMEO.Location())),
 			error: nil,
 		},
 		{
@@ -316,8 +383,7 @@ func TestParseDTG(t *testing.T) {
 		{
 			name:  "month and timezone without year",
 			input: "010100ZJAN",
-			want: NewTime(time.Date(time.Now().UTC().Year(),
-				time.January, 1, 1, 0, 0, 0, ZULU.Location())),
+			want:  NewTime(time.Date(2024, 1, 1, 1, 0, 0, 0, ZULU.Location())),
 			error: nil,
 		},
 		{
@@ -335,8 +401,7 @@ func TestParseDTG(t *testing.T) {
 		{
 			name:  "all digits with no seconds and four-digit year",
 			input: "0101002021",
-			want: NewTime(time.Date(2021, time.Now().UTC().Month(),
-				1, 1, 0, 0, 0, ZULU.Location())),
+			want:  NewTime(time.Date(2021, time.December, 1, 1, 0, 0, 0, ZULU.Location())),
 			error: nil,
 		},
 		{
@@ -375,11 +440,64 @@ func TestParseDTG(t *testing.T) {
 			want:  Time{},
 			error: ErrInvalidDateTimeGroup,
 		},
+		{
+			name:  "half-hour timezone",
+			input: "011200E* JAN 24",
+			want:  NewTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.FixedZone("E*", 5*3600+30*60))),
+			error: nil,
+		},
+		{
+			name:  "negative half-hour timezone without spaces",
+			input: "011200p*jan2024",
+			want:  NewTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.FixedZone("P*", -3*3600-30*60))),
+			error: nil,
+		},
+		{
+			name:  "three-quarter-hour timezone",
+			input: "011200E†JAN24",
+			want:  NewTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.FixedZone("E†", 5*3600+45*60))),
+			error: nil,
+		},
+		{
+			name:  "double prime timezone",
+			input: "011200M'' JAN 24",
+			want:  NewTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.FixedZone("M''", 14*3600))),
+			error: nil,
+		},
+		{
+			name:  "suffix without month",
+			input: "011200M'",
+			want:  NewTime(time.Date(2024, 3, 1, 12, 0, 0, 0, time.FixedZone("M'", 13*3600))),
+			error: nil,
+		},
+		{
+			name:  "unassigned suffix",
+			input: "011200Z*JAN24",
+			want:  Time{},
+			error: ErrInvalidDateTimeGroup,
+		},
+		{
+			name:  "suffix after month",
+			input: "011200ZJAN*24",
+			want:  Time{},
+			error: ErrInvalidDateTimeGroup,
+		},
+		{
+			name:  "triple prime",
+			input: "011200M'''JAN24",
+			want:  Time{},
+			error: ErrInvalidDateTimeGroup,
+		},
+		{
+			name:  "suffix with misspelled month",
+			input: "011200E*JANN24",
+			want:  Time{},
+			error: ErrInvalidMonth,
+		},
 		{
 			name:  "juliet timezone",
 			input: "010100J",
-			want: NewTime(time.Date(time.Now().UTC().Year(),
-				time.Now().UTC().Month(), 1, 1, 0, 0, 0, JULIET.Location())),
+			want:  NewTime(time.Date(2024, 3, 1, 1, 0, 0, 0, JULIET.Location())),
 			error: nil,
 		},
 		{
@@ -392,7 +510,7 @@ func TestParseDTG(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := ParseDTG(tt.input)
+			got, err := p.Parse(tt.input)
 			if !errors.Is(err, tt.error) {
 				t.Errorf("got %v, want %v", err, tt.error)
 			}
@@ -466,6 +584,32 @@ func BenchmarkParseDTG(b *testing.B) {
 	}
 }
 
+func BenchmarkParseDTGBytes(b *testing.B) {
+	b.ReportAllocs()
+
+	in := []byte("01010059R JAN 2021")
+
+	for i := 0; i < b.N; i++ {
+		t, err := ParseDTGBytes(in)
+		if err != nil {
+			b.Fatalf("unexpected error: %v", err)
+		}
+
+		_ = t
+	}
+}
+
+func BenchmarkTime_AppendFormat(b *testing.B) {
+	b.ReportAllocs()
+
+	t := NewTime(time.Date(2021, 1, 1, 1, 0, 59, 0, ROMEO.Location()))
+	buf := make([]byte, 0, 32)
+
+	for i := 0; i < b.N; i++ {
+		buf = t.AppendFormat(buf[:0], MILDTGFULLYEAR)
+	}
+}
+
 func BenchmarkTime_String(b *testing.B) {
 	b.ReportAllocs()
 
@@ -475,3 +619,143 @@ func BenchmarkTime_String(b *testing.B) {
 		_ = t.String()
 	}
 }
+
+func TestParseDTG_EndOfDay(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  time.Time
+		error error
+	}{
+		{
+			name:  "end of year",
+			input: "312400Z DEC 24",
+			want:  time.Date(2025, 1, 1, 0, 0, 0, 0, ZULU.Location()),
+		},
+		{
+			name:  "end of february",
+			input: "282400R FEB 2023",
+			want:  time.Date(2023, 3, 1, 0, 0, 0, 0, ROMEO.Location()),
+		},
+		{
+			name:  "with zero seconds",
+			input: "15240000Z JUN 2024",
+			want:  time.Date(2024, 6, 16, 0, 0, 0, 0, ZULU.Location()),
+		},
+		{
+			name:  "day does not exist",
+			input: "292400Z FEB 2023",
+			error: ErrInvalidDay,
+		},
+		{
+			name:  "minutes after 2400",
+			input: "312401Z DEC 24",
+			error: ErrInvalidDateTimeGroup,
+		},
+		{
+			name:  "seconds after 2400",
+			input: "31240001Z DEC 24",
+			error: ErrInvalidDateTimeGroup,
+		},
+		{
+			name:  "hour 25",
+			input: "312500Z DEC 24",
+			error: ErrInvalidDateTimeGroup,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDTG(tt.input)
+			if !errors.Is(err, tt.error) {
+				t.Fatalf("got %v, want %v", err, tt.error)
+			}
+
+			if err == nil && (!got.Equal(tt.want) || got.Location() != tt.want.Location()) {
+				t.Errorf("got %v, want %v", got.Time, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDTGInLocation_EndOfDay(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone database unavailable: %v", err)
+	}
+
+	// Midnight at the end of the last day of daylight saving time.
+	got, err := ParseDTGInLocation("022400J NOV 2024", newYork)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := time.Date(2024, 11, 3, 0, 0, 0, 0, newYork)
+	if !got.Equal(want) {
+		t.Errorf("got %v, want %v", got.Time, want)
+	}
+}
+
+func TestParseDTGInLocation(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone database unavailable: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		want  time.Time
+		error error
+	}{
+		{
+			name:  "juliet in standard time",
+			input: "010100J JAN 2024",
+			want:  time.Date(2024, 1, 1, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "juliet in daylight saving time",
+			input: "010100J JUL 2024",
+			want:  time.Date(2024, 7, 1, 5, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "juliet skipped when clocks move forward",
+			input: "100230J MAR 2024",
+			error: ErrNonexistentLocalTime,
+		},
+		{
+			name:  "juliet repeated when clocks move back",
+			input: "030130J NOV 2024",
+			error: ErrAmbiguousLocalTime,
+		},
+		{
+			name:  "juliet just after clocks move back",
+			input: "030200J NOV 2024",
+			want:  time.Date(2024, 11, 3, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "lettered zones ignore the location",
+			input: "010100R JUL 2024",
+			want:  time.Date(2024, 7, 1, 6, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDTGInLocation(tt.input, newYork)
+			if !errors.Is(err, tt.error) {
+				t.Fatalf("got %v, want %v", err, tt.error)
+			}
+
+			if !got.Equal(tt.want) {
+				t.Errorf("got %v, want %v", got.UTC(), tt.want)
+			}
+		})
+	}
+}
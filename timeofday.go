@@ -0,0 +1,293 @@
+package mildtg
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MILTIME is the layout for a time of day in a military time zone, such
+// as "1430Z" or "143015R".
+const MILTIME = "hhmm[ss]Z"
+
+const invalidTimeOfDay = "INVALID TIME"
+
+// hoursSuffix is the word that may follow a spoken time, as in "1430 HOURS".
+const hoursSuffix = "HOURS"
+
+// TimeOfDay is a time of day in a military time zone without a date,
+// such as "1430Z". Use Date.At to combine it with a Date into a Time.
+//
+// Hour is 0 to 23, or 24 for midnight at the end of the day when Minute
+// and Second are zero. The zero Zone is Zulu.
+type TimeOfDay struct {
+	Hour   int
+	Minute int
+	Second int
+	Zone   TimeZone
+}
+
+// TimeOfDayOf returns the time of day of t and the military time zone
+// for its UTC offset. Times whose offset has no military time zone letter
+// are converted to Zulu. Juliet is never reported, even for times in
+// time.Local.
+func TimeOfDayOf(t Time) TimeOfDay {
+	tz, ok := zoneOf(t.Time)
+	if !ok {
+		tz, t = ZULU, NewTime(t.Time.In(ZULU.Location()))
+	}
+
+	h, m, s := t.Clock()
+
+	return TimeOfDay{Hour: h, Minute: m, Second: s, Zone: tz}
+}
+
+// ParseTimeOfDay parses a time of day written as four or six digits for
+// the hours, minutes and optional seconds, followed by an optional time
+// zone designation and the optional word HOURS, such as "1430Z",
+// "143015R", "1430 HOURS" or "1430J HOURS". The designation may carry a
+// suffix such as "E*" (see ExtendedZones), and without one the time is
+// Zulu. The time 2400 is midnight at the end of the day.
+//
+// ParseTimeOfDay is equivalent to Parser{}.ParseTimeOfDay(s).
+func ParseTimeOfDay(s string) (TimeOfDay, error) {
+	return Parser{}.ParseTimeOfDay(s)
+}
+
+// ParseTimeOfDay parses a time of day as described for the package-level
+// ParseTimeOfDay, using the parser's DefaultZone when no zone is given.
+// With Strict set, the time must be written in the MILTIME layout with
+// an upper-case designation.
+func (p Parser) ParseTimeOfDay(s string) (TimeOfDay, error) {
+	var f dtgFields
+
+	n := digitLen(s, 0)
+	switch {
+	case n == 0:
+		return TimeOfDay{}, layoutMissing(s, 0, ComponentHour)
+	case n < 4:
+		return TimeOfDay{}, newParseError(s, n, ComponentMinute, ErrNotEnoughChars,
+			"expected two-digit %s", ComponentMinute)
+	case n == 5 || n > 6:
+		return TimeOfDay{}, newParseError(s, 0, ComponentNone, ErrInvalidDateTimeGroup,
+			"time %q must have four or six digits", s[:n])
+	}
+
+	f.hour, _ = strictDigits(s, 0, 2)
+	f.minute, _ = strictDigits(s, 2, 2)
+	f.hourPos, f.minutePos = 0, 2
+	if n == 6 {
+		f.seconds, _ = strictDigits(s, 4, 2)
+		f.secondsPos, f.hasSeconds = 4, true
+	}
+
+	if err := checkClock(s, f); err != nil {
+		return TimeOfDay{}, err
+	}
+
+	i := n
+	if !p.Strict {
+		i = skipSpaces(s, i)
+	}
+
+	// An optional zone designation, unless this is the word HOURS.
+	if w := layoutWordLen(s, i); w > 0 && (p.Strict || !strings.EqualFold(s[i:i+w], hoursSuffix)) {
+		if p.Strict && s[i] >= 'a' && s[i] <= 'z' {
+			return TimeOfDay{}, newParseError(s, i, ComponentZone, ErrInvalidDateTimeGroup,
+				"time zone %q must be upper case", s[i:i+1])
+		}
+
+		n := layoutDesignatorLen(s, i)
+
+		tz, ok := zoneByLetterOrJuliet(rune(s[i]))
+		if n > 1 {
+			tz, ok = zoneByDesignator(rune(s[i]), s[i+1:i+n])
+		}
+
+		if !ok {
+			return TimeOfDay{}, newParseError(s, i, ComponentZone, ErrInvalidDateTimeGroup,
+				"unknown time zone %q", s[i:i+n])
+		}
+
+		f.tz, f.hasZone, f.zonePos = tz, true, i
+		i += n
+
+		if !p.Strict {
+			i = skipSpaces(s, i)
+		}
+	} else if p.Strict {
+		return TimeOfDay{}, newParseError(s, i, ComponentZone, ErrInvalidDateTimeGroup,
+			"missing time zone")
+	}
+
+	if w := layoutWordLen(s, i); !p.Strict && w > 0 && strings.EqualFold(s[i:i+w], hoursSuffix) {
+		i += w
+	}
+
+	if i < len(s) {
+		r, _ := utf8.DecodeRuneInString(s[i:])
+		return TimeOfDay{}, newParseError(s, i, ComponentNone, ErrInvalidDateTimeGroup,
+			"unexpected character %q", r)
+	}
+
+	if !f.hasZone {
+		f.tz = p.defaultZone()
+	}
+
+	return TimeOfDay{Hour: f.hour, Minute: f.minute, Second: f.seconds, Zone: f.tz}, nil
+}
+
+// IsZero reports whether tod is 0000Z, the time of the zero TimeOfDay,
+// with its Zone unset or Zulu.
+func (tod TimeOfDay) IsZero() bool {
+	return tod.Hour == 0 && tod.Minute == 0 && tod.Second == 0 && tod.zone() == ZULU
+}
+
+// IsValid reports whether tod is a time of day that exists, with 2400
+// as the only time with hour 24.
+func (tod TimeOfDay) IsValid() bool {
+	if tod.Hour == 24 {
+		return tod.Minute == 0 && tod.Second == 0
+	}
+
+	return tod.Hour >= 0 && tod.Hour < 24 &&
+		tod.Minute >= 0 && tod.Minute < 60 &&
+		tod.Second >= 0 && tod.Second < 60
+}
+
+// zone returns the time zone of tod, which is Zulu if unset.
+func (tod TimeOfDay) zone() TimeZone {
+	if tod.Zone == (TimeZone{}) {
+		return ZULU
+	}
+
+	return tod.Zone
+}
+
+// Format returns tod formatted according to layout, using the time and
+// zone tokens described for Time.Format, such as MILTIME or
+// "hhmm ZONE". Both hh and kk write the hour as given, so 2400 is written
+// as "2400Z" and 0000 as "0000Z". Invalid times are written as
+// "INVALID TIME".
+func (tod TimeOfDay) Format(layout string) string {
+	b, err := tod.appendFormat(make([]byte, 0, 16), layout)
+	if err != nil {
+		return invalidTimeOfDay
+	}
+
+	return string(b)
+}
+
+// String returns the time of day in the MILTIME layout, such as "1430Z".
+func (tod TimeOfDay) String() string {
+	return tod.Format(MILTIME)
+}
+
+// appendFormat appends tod formatted according to layout to b.
+func (tod TimeOfDay) appendFormat(b []byte, layout string) ([]byte, error) {
+	if !tod.IsValid() {
+		return b, fmt.Errorf("%w: %02d:%02d:%02d does not exist", ErrInvalidDateTimeGroup,
+			tod.Hour, tod.Minute, tod.Second)
+	}
+
+	// Any date within the default year bounds will do.
+	t := Date{Year: 2000, Month: time.January, Day: 1}.At(tod)
+
+	l, ok := dtgLayout(layout)
+	if !ok {
+		return t.Time.AppendFormat(b, layout), nil
+	}
+
+	// The hour is written as given, so that 2400 and 0000 read back
+	// unchanged whether the layout uses hh or kk.
+	return Formatter{}.appendFields(b, t.Time, tod.zone(), l, tod.Hour == 24)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The time is a quoted string in the MILTIME layout. The zero TimeOfDay
+// is the valid time 0000Z and is encoded as "0000Z".
+func (tod TimeOfDay) MarshalJSON() ([]byte, error) {
+	b := make([]byte, 0, 16)
+	b = append(b, '"')
+	b, err := tod.appendFormat(b, MILTIME)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(b, '"'), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The time must be a quoted string accepted by ParseTimeOfDay, an empty
+// string or null. Both null and the empty string leave the zero TimeOfDay,
+// which is 0000Z.
+func (tod *TimeOfDay) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, jsonNull) {
+		*tod = TimeOfDay{}
+		return nil
+	}
+
+	text, err := unquoteJSON(data, "mildtg.TimeOfDay")
+	if err != nil {
+		return err
+	}
+
+	return tod.UnmarshalText(text)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The time is formatted in the MILTIME layout, and the zero TimeOfDay is
+// encoded as "0000Z".
+func (tod TimeOfDay) MarshalText() ([]byte, error) {
+	return tod.appendFormat(nil, MILTIME)
+}
+
+// AppendText implements the encoding.TextAppender interface.
+// It appends the same encoding as MarshalText to b.
+func (tod TimeOfDay) AppendText(b []byte) ([]byte, error) {
+	return tod.appendFormat(b, MILTIME)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The time must be accepted by ParseTimeOfDay or empty. A time that is
+// 0000Z leaves the zero TimeOfDay, so that it reads back as it was
+// written.
+func (tod *TimeOfDay) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*tod = TimeOfDay{}
+		return nil
+	}
+
+	out, err := ParseTimeOfDay(string(data))
+	if err != nil {
+		return err
+	}
+
+	if out.IsZero() {
+		out = TimeOfDay{}
+	}
+
+	*tod = out
+
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// The encoding is the same as MarshalText.
+func (tod TimeOfDay) MarshalBinary() ([]byte, error) {
+	return tod.MarshalText()
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface.
+// The encoding is the same as AppendText.
+func (tod TimeOfDay) AppendBinary(b []byte) ([]byte, error) {
+	return tod.AppendText(b)
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// The encoding is the same as UnmarshalText.
+func (tod *TimeOfDay) UnmarshalBinary(data []byte) error {
+	return tod.UnmarshalText(data)
+}
@@ -0,0 +1,262 @@
+package mildtg
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParser_ParseTimeOfDay(t *testing.T) {
+	t.Parallel()
+
+	echoHalf, _ := ZoneByDesignator("E*")
+
+	tests := []struct {
+		name   string
+		parser Parser
+		input  string
+		want   TimeOfDay
+		error  error
+	}{
+		{name: "zulu", input: "1430Z", want: TimeOfDay{Hour: 14, Minute: 30, Zone: ZULU}},
+		{name: "romeo", input: "1430R", want: TimeOfDay{Hour: 14, Minute: 30, Zone: ROMEO}},
+		{name: "seconds", input: "143015R", want: TimeOfDay{Hour: 14, Minute: 30, Second: 15, Zone: ROMEO}},
+		{name: "hours", input: "1430 HOURS", want: TimeOfDay{Hour: 14, Minute: 30, Zone: ZULU}},
+		{name: "zone and hours", input: "1430J hours", want: TimeOfDay{Hour: 14, Minute: 30, Zone: JULIET}},
+		{name: "spaced zone", input: "0600 r", want: TimeOfDay{Hour: 6, Zone: ROMEO}},
+		{name: "suffixed zone", input: "0600E*", want: TimeOfDay{Hour: 6, Zone: echoHalf}},
+		{name: "no zone", input: "0600", want: TimeOfDay{Hour: 6, Zone: ZULU}},
+		{
+			name:   "default zone",
+			parser: Parser{DefaultZone: ROMEO},
+			input:  "0600 HOURS",
+			want:   TimeOfDay{Hour: 6, Zone: ROMEO},
+		},
+		{name: "end of day", input: "2400Z", want: TimeOfDay{Hour: 24, Zone: ZULU}},
+		{name: "hour out of range", input: "2500Z", error: ErrInvalidDateTimeGroup},
+		{name: "2401", input: "2401Z", error: ErrInvalidDateTimeGroup},
+		{name: "minute out of range", input: "1460Z", error: ErrInvalidDateTimeGroup},
+		{name: "too short", input: "143Z", error: ErrNotEnoughChars},
+		{name: "five digits", input: "14301Z", error: ErrInvalidDateTimeGroup},
+		{name: "unknown zone", input: "1430A*", error: ErrInvalidDateTimeGroup},
+		{name: "trailing text", input: "1430Z LOCAL", error: ErrInvalidDateTimeGroup},
+		{name: "empty", input: "", error: ErrNotEnoughChars},
+		{name: "strict", parser: Parser{Strict: true}, input: "143015R", want: TimeOfDay{14, 30, 15, ROMEO}},
+		{name: "strict without zone", parser: Parser{Strict: true}, input: "1430", error: ErrInvalidDateTimeGroup},
+		{name: "strict hours", parser: Parser{Strict: true}, input: "1430 HOURS", error: ErrInvalidDateTimeGroup},
+		{name: "strict lower case", parser: Parser{Strict: true}, input: "1430r", error: ErrInvalidDateTimeGroup},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parser.ParseTimeOfDay(tt.input)
+			if !errors.Is(err, tt.error) {
+				t.Fatalf("got %v, want %v", err, tt.error)
+			}
+
+			if err != nil {
+				var pe *ParseError
+				if !errors.As(err, &pe) {
+					t.Errorf("got %T, want *ParseError", err)
+				}
+			}
+
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeOfDay_Format(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		input  TimeOfDay
+		layout string
+		want   string
+	}{
+		{name: "MILTIME", input: TimeOfDay{Hour: 14, Minute: 30, Zone: ROMEO}, layout: MILTIME, want: "1430R"},
+		{name: "seconds", input: TimeOfDay{14, 30, 15, ROMEO}, layout: MILTIME, want: "143015R"},
+		{name: "zero zone", input: TimeOfDay{Hour: 9}, layout: MILTIME, want: "0900Z"},
+		{name: "zone name", input: TimeOfDay{Hour: 9, Zone: ROMEO}, layout: "hhmm ZONE", want: "0900 ROMEO"},
+		{name: "juliet", input: TimeOfDay{Hour: 9, Zone: JULIET}, layout: MILTIME, want: "0900J"},
+		{name: "end of day", input: TimeOfDay{Hour: 24, Zone: ZULU}, layout: "kkmmZ", want: "2400Z"},
+		{name: "end of day with hh", input: TimeOfDay{Hour: 24, Zone: ZULU}, layout: MILTIME, want: "2400Z"},
+		{name: "start of day with kk", input: TimeOfDay{}, layout: "kkmmZ", want: "0000Z"},
+		{name: "quoted kk", input: TimeOfDay{Hour: 24}, layout: "'kk' hhmmZ", want: "kk 2400Z"},
+		{name: "invalid", input: TimeOfDay{Hour: 24, Minute: 1}, layout: MILTIME, want: invalidTimeOfDay},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.Format(tt.layout); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := (TimeOfDay{Hour: 14, Minute: 30, Zone: ROMEO}).String(); got != "1430R" {
+		t.Errorf("got %v, want %v", got, "1430R")
+	}
+}
+
+func TestTimeOfDayOf(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input time.Time
+		want  TimeOfDay
+	}{
+		{
+			name:  "letter zone",
+			input: time.Date(2024, 1, 15, 14, 30, 15, 0, ROMEO.Location()),
+			want:  TimeOfDay{14, 30, 15, ROMEO},
+		},
+
+		{
+			name:  "offset without a letter",
+			input: time.Date(2024, 1, 15, 14, 30, 0, 0, time.FixedZone("", 90*60)),
+			want:  TimeOfDay{Hour: 13, Zone: ZULU},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TimeOfDayOf(NewTime(tt.input)); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayOf_Local(t *testing.T) {
+	t.Parallel()
+
+	in := time.Date(2024, 1, 15, 14, 30, 0, 0, time.Local)
+
+	got := TimeOfDayOf(NewTime(in))
+	if got.Zone == JULIET {
+		t.Fatalf("got %v, want the zone for the offset", got)
+	}
+
+	h, m, _ := in.In(got.Zone.Location()).Clock()
+	if got.Hour != h || got.Minute != m {
+		t.Errorf("got %v, want %02d%02d%v", got, h, m, got.Zone)
+	}
+}
+
+func TestTimeOfDay_JSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	type record struct {
+		Start TimeOfDay `json:"start"`
+		Zero  TimeOfDay `json:"zero"`
+	}
+
+	in := record{Start: TimeOfDay{Hour: 14, Minute: 30, Zone: ROMEO}}
+
+	b, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if want := `{"start":"1430R","zero":"0000Z"}`; string(b) != want {
+		t.Errorf("got %s, want %s", b, want)
+	}
+
+	var out record
+	if err := json.Unmarshal(b, &out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if out != in {
+		t.Errorf("got %v, want %v", out, in)
+	}
+
+	if err := json.Unmarshal([]byte(`{"zero":null}`), &out); err != nil || out.Zero != (TimeOfDay{}) {
+		t.Errorf("got %v, %v, want %v", out.Zero, err, TimeOfDay{})
+	}
+
+	echoDagger, _ := ZoneByDesignator("E†")
+	if err := json.Unmarshal([]byte(`{"start":"1430E\u2020"}`), &out); err != nil || out.Start != (TimeOfDay{14, 30, 0, echoDagger}) {
+		t.Errorf("got %v, %v, want %v", out.Start, err, TimeOfDay{14, 30, 0, echoDagger})
+	}
+
+	if err := json.Unmarshal([]byte(`{"start":1430}`), &out); !errors.Is(err, ErrInvalidDateTimeGroup) {
+		t.Errorf("got %v, want %v", err, ErrInvalidDateTimeGroup)
+	}
+}
+
+func TestTimeOfDay_RoundTripEndOfDay(t *testing.T) {
+	t.Parallel()
+
+	d := Date{Year: 2024, Month: time.December, Day: 31}
+
+	for _, in := range []TimeOfDay{{Hour: 24, Zone: ZULU}, {}} {
+		b, err := json.Marshal(in)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		var out TimeOfDay
+		if err := json.Unmarshal(b, &out); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if out != in {
+			t.Errorf("got %v, want %v", out, in)
+		}
+
+		if out.IsZero() != (in.Hour == 0) {
+			t.Errorf("got %v, want %v", out.IsZero(), in.Hour == 0)
+		}
+
+		if got, want := d.At(out), d.At(in); !got.Equal(want.Time) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	}
+
+	if !(TimeOfDay{Zone: ZULU}).IsZero() {
+		t.Errorf("got %v, want %v", false, true)
+	}
+}
+
+func TestTimeOfDay_MarshalBinary(t *testing.T) {
+	t.Parallel()
+
+	in := TimeOfDay{14, 30, 15, ROMEO}
+
+	b, err := in.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if string(b) != "143015R" {
+		t.Errorf("got %s, want %s", b, "143015R")
+	}
+
+	var out TimeOfDay
+	if err := out.UnmarshalBinary(b); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if out != in {
+		t.Errorf("got %v, want %v", out, in)
+	}
+
+	if _, err := (TimeOfDay{Hour: 25}).MarshalText(); !errors.Is(err, ErrInvalidDateTimeGroup) {
+		t.Errorf("got %v, want %v", err, ErrInvalidDateTimeGroup)
+	}
+
+	if b, err = (TimeOfDay{}).AppendText([]byte("at ")); err != nil || string(b) != "at 0000Z" {
+		t.Errorf("got %q, %v, want %q", b, err, "at 0000Z")
+	}
+
+	if b, err = (TimeOfDay{}).MarshalText(); err != nil || string(b) != "0000Z" {
+		t.Errorf("got %q, %v, want %q", b, err, "0000Z")
+	}
+}
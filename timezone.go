@@ -34,65 +34,117 @@ const (
 	zulu     = 'Z'
 )
 
-// TimeZone represents a time zone designation.
-type timeZone struct {
-	letter rune  // time zone designation letter (Zulu, Alpha, Bravo, etc.)
-	offset int32 // number of seconds east of UTC/GMT (positive) or west of UTC/GMT (negative)
+// TimeZone represents a military time zone designation.
+type TimeZone struct {
+	letter rune   // time zone designation letter (Zulu, Alpha, Bravo, etc.)
+	suffix string // designation suffix for non whole-hour zones (*, †, ', '')
+	name   string // phonetic name of the designation (ZULU, ALPHA, BRAVO, etc.)
+	offset int32  // number of seconds east of UTC/GMT (positive) or west of UTC/GMT (negative)
 }
 
-// String returns the time zone designation letter.
-func (tz timeZone) String() string {
-	return strings.ToUpper(string(tz.letter))
+// String returns the time zone designation, which is the letter
+// followed by any suffix.
+func (tz TimeZone) String() string {
+	return strings.ToUpper(string(tz.letter)) + tz.suffix
+}
+
+// Letter returns the time zone designation letter without any suffix.
+func (tz TimeZone) Letter() rune {
+	return tz.letter
+}
+
+// Name returns the phonetic name of the time zone designation.
+func (tz TimeZone) Name() string {
+	return tz.name
 }
 
 // Offset returns seconds east of UTC/GMT (positive) or west of UTC/GMT (negative).
-func (tz timeZone) Offset() int {
+func (tz TimeZone) Offset() int {
 	return int(tz.offset)
 }
 
 // Location returns the time.Location for the time zone.
-func (tz timeZone) Location() *time.Location {
+// Juliet has no fixed offset and returns time.Local.
+func (tz TimeZone) Location() *time.Location {
+	if tz == JULIET {
+		return time.Local
+	}
+
+	if loc, ok := zoneLocations[tz]; ok {
+		return loc
+	}
+
 	return time.FixedZone(tz.String(), int(tz.offset))
 }
 
+// zoneLocations caches the fixed location of each time zone so that
+// parsing does not allocate a new one for every date-time-group.
+var zoneLocations = newZoneLocations()
+
+// newZoneLocations returns the fixed location of every zone in
+// zoneTable and extendedZoneTable.
+func newZoneLocations() map[TimeZone]*time.Location {
+	locs := make(map[TimeZone]*time.Location, len(zoneTable)+len(extendedZoneTable))
+	for _, table := range [][]TimeZone{zoneTable, extendedZoneTable} {
+		for _, tz := range table {
+			locs[tz] = time.FixedZone(tz.String(), int(tz.offset))
+		}
+	}
+
+	return locs
+}
+
+const (
+	secondsInHour   int32 = 3600 // seconds in an hour
+	secondsInMinute int32 = 60   // seconds in a minute
+)
+
+// Designation suffixes for zones that are not a whole number of hours
+// from UTC or that lie beyond Mike and Yankee. The asterisk and dagger
+// move the lettered zone a further 30 and 45 minutes away from UTC, and
+// each prime mark moves Mike a further hour east.
 const (
-	secondsInHour int32 = 3600 // seconds in an hour
+	suffixHalfHour         = "*"
+	suffixThreeQuarterHour = "†"
+	suffixPrime            = "'"
+	suffixDoublePrime      = "''"
 )
 
 // Time zone designations skip J (Juliet).
-// J is used to indicate the local time zone.
+// J is used to indicate the local time zone, so JULIET
+// has no fixed offset.
 
 var (
-	ZULU     = timeZone{zulu, 0 * secondsInHour}      // Zulu GMT +0
-	ALPHA    = timeZone{alpha, 1 * secondsInHour}     // Alpha GMT +1
-	BRAVO    = timeZone{bravo, 2 * secondsInHour}     // Bravo GMT +2
-	CHARLIE  = timeZone{charlie, 3 * secondsInHour}   // Charlie GMT +3
-	DELTA    = timeZone{delta, 4 * secondsInHour}     // Delta GMT +4
-	ECHO     = timeZone{echo, 5 * secondsInHour}      // Echo GMT +5
-	FOXTROT  = timeZone{foxtrot, 6 * secondsInHour}   // Foxtrot GMT +6
-	GOLF     = timeZone{golf, 7 * secondsInHour}      // Golf GMT +7
-	HOTEL    = timeZone{hotel, 8 * secondsInHour}     // Hotel GMT +8
-	INDIA    = timeZone{india, 9 * secondsInHour}     // India GMT +9
-	JULIET   = timeZone{juliet, 0}                    // Juliet local time zone
-	KILO     = timeZone{kilo, 10 * secondsInHour}     // Kilo GMT +10
-	LIMA     = timeZone{lima, 11 * secondsInHour}     // Lima GMT +11
-	MIKE     = timeZone{mike, 12 * secondsInHour}     // Mike GMT +12
-	NOVEMBER = timeZone{november, -1 * secondsInHour} // November GMT -1
-	OSCAR    = timeZone{oscar, -2 * secondsInHour}    // Oscar GMT -2
-	PAPA     = timeZone{papa, -3 * secondsInHour}     // Papa GMT -3
-	QUEBEC   = timeZone{quebec, -4 * secondsInHour}   // Quebec GMT -4
-	ROMEO    = timeZone{romeo, -5 * secondsInHour}    // Romeo GMT -5
-	SIERRA   = timeZone{sierra, -6 * secondsInHour}   // Sierra GMT -6
-	TANGO    = timeZone{tango, -7 * secondsInHour}    // Tango GMT -7
-	UNIFORM  = timeZone{uniform, -8 * secondsInHour}  // Uniform GMT -8
-	VICTOR   = timeZone{victor, -9 * secondsInHour}   // Victor GMT -9
-	WHISKEY  = timeZone{whiskey, -10 * secondsInHour} // Whiskey GMT -10
-	XRAY     = timeZone{xray, -11 * secondsInHour}    // X-ray GMT -11
-	YANKEE   = timeZone{yankee, -12 * secondsInHour}  // Yankee GMT -12
+	ZULU     = TimeZone{zulu, "", "ZULU", 0 * secondsInHour}          // Zulu GMT +0
+	ALPHA    = TimeZone{alpha, "", "ALPHA", 1 * secondsInHour}        // Alpha GMT +1
+	BRAVO    = TimeZone{bravo, "", "BRAVO", 2 * secondsInHour}        // Bravo GMT +2
+	CHARLIE  = TimeZone{charlie, "", "CHARLIE", 3 * secondsInHour}    // Charlie GMT +3
+	DELTA    = TimeZone{delta, "", "DELTA", 4 * secondsInHour}        // Delta GMT +4
+	ECHO     = TimeZone{echo, "", "ECHO", 5 * secondsInHour}          // Echo GMT +5
+	FOXTROT  = TimeZone{foxtrot, "", "FOXTROT", 6 * secondsInHour}    // Foxtrot GMT +6
+	GOLF     = TimeZone{golf, "", "GOLF", 7 * secondsInHour}          // Golf GMT +7
+	HOTEL    = TimeZone{hotel, "", "HOTEL", 8 * secondsInHour}        // Hotel GMT +8
+	INDIA    = TimeZone{india, "", "INDIA", 9 * secondsInHour}        // India GMT +9
+	JULIET   = TimeZone{juliet, "", "JULIET", 0}                      // Juliet local time zone
+	KILO     = TimeZone{kilo, "", "KILO", 10 * secondsInHour}         // Kilo GMT +10
+	LIMA     = TimeZone{lima, "", "LIMA", 11 * secondsInHour}         // Lima GMT +11
+	MIKE     = TimeZone{mike, "", "MIKE", 12 * secondsInHour}         // Mike GMT +12
+	NOVEMBER = TimeZone{november, "", "NOVEMBER", -1 * secondsInHour} // November GMT -1
+	OSCAR    = TimeZone{oscar, "", "OSCAR", -2 * secondsInHour}       // Oscar GMT -2
+	PAPA     = TimeZone{papa, "", "PAPA", -3 * secondsInHour}         // Papa GMT -3
+	QUEBEC   = TimeZone{quebec, "", "QUEBEC", -4 * secondsInHour}     // Quebec GMT -4
+	ROMEO    = TimeZone{romeo, "", "ROMEO", -5 * secondsInHour}       // Romeo GMT -5
+	SIERRA   = TimeZone{sierra, "", "SIERRA", -6 * secondsInHour}     // Sierra GMT -6
+	TANGO    = TimeZone{tango, "", "TANGO", -7 * secondsInHour}       // Tango GMT -7
+	UNIFORM  = TimeZone{uniform, "", "UNIFORM", -8 * secondsInHour}   // Uniform GMT -8
+	VICTOR   = TimeZone{victor, "", "VICTOR", -9 * secondsInHour}     // Victor GMT -9
+	WHISKEY  = TimeZone{whiskey, "", "WHISKEY", -10 * secondsInHour}  // Whiskey GMT -10
+	XRAY     = TimeZone{xray, "", "XRAY", -11 * secondsInHour}        // X-ray GMT -11
+	YANKEE   = TimeZone{yankee, "", "YANKEE", -12 * secondsInHour}    // Yankee GMT -12
 )
 
 var (
-	timeZones = map[rune]timeZone{
+	timeZones = map[rune]TimeZone{
 		zulu:     ZULU,
 		alpha:    ALPHA,
 		bravo:    BRAVO,
@@ -120,3 +172,134 @@ var (
 		yankee:   YANKEE,
 	}
 )
+
+// zoneTable lists the time zones in designation order, from Zulu
+// eastward through Mike and then westward from November through Yankee.
+var zoneTable = []TimeZone{
+	ZULU, ALPHA, BRAVO, CHARLIE, DELTA, ECHO, FOXTROT, GOLF, HOTEL, INDIA, KILO, LIMA, MIKE,
+	NOVEMBER, OSCAR, PAPA, QUEBEC, ROMEO, SIERRA, TANGO, UNIFORM, VICTOR, WHISKEY, XRAY, YANKEE,
+}
+
+// zoneByLetterOrJuliet returns the time zone for the designation letter,
+// including Juliet.
+func zoneByLetterOrJuliet(letter rune) (TimeZone, bool) {
+	if letter == juliet || letter == juliet+('a'-'A') {
+		return JULIET, true
+	}
+
+	return ZoneByLetter(letter)
+}
+
+// extendedZoneTable lists the zones written with a designation suffix,
+// ordered by offset from UTC.
+var extendedZoneTable = []TimeZone{
+	{charlie, suffixHalfHour, "CHARLIE*", 3*secondsInHour + 30*secondsInMinute},     // Iran GMT +3:30
+	{delta, suffixHalfHour, "DELTA*", 4*secondsInHour + 30*secondsInMinute},         // Afghanistan GMT +4:30
+	{echo, suffixHalfHour, "ECHO*", 5*secondsInHour + 30*secondsInMinute},           // India GMT +5:30
+	{echo, suffixThreeQuarterHour, "ECHO†", 5*secondsInHour + 45*secondsInMinute},   // Nepal GMT +5:45
+	{foxtrot, suffixHalfHour, "FOXTROT*", 6*secondsInHour + 30*secondsInMinute},     // Myanmar GMT +6:30
+	{hotel, suffixThreeQuarterHour, "HOTEL†", 8*secondsInHour + 45*secondsInMinute}, // Eucla GMT +8:45
+	{india, suffixHalfHour, "INDIA*", 9*secondsInHour + 30*secondsInMinute},         // Central Australia GMT +9:30
+	{kilo, suffixHalfHour, "KILO*", 10*secondsInHour + 30*secondsInMinute},          // Lord Howe Island GMT +10:30
+	{mike, suffixThreeQuarterHour, "MIKE†", 12*secondsInHour + 45*secondsInMinute},  // Chatham Islands GMT +12:45
+	{mike, suffixPrime, "MIKE'", 13 * secondsInHour},                                // Tonga, Phoenix Islands GMT +13
+	{mike, suffixDoublePrime, "MIKE''", 14 * secondsInHour},                         // Line Islands GMT +14
+	{oscar, suffixHalfHour, "OSCAR*", -2*secondsInHour - 30*secondsInMinute},        // Newfoundland daylight GMT -2:30
+	{papa, suffixHalfHour, "PAPA*", -3*secondsInHour - 30*secondsInMinute},          // Newfoundland GMT -3:30
+	{victor, suffixHalfHour, "VICTOR*", -9*secondsInHour - 30*secondsInMinute},      // Marquesas Islands GMT -9:30
+}
+
+// AllZones returns the 25 military time zones in designation order.
+// Juliet is not included because it does not have a fixed offset.
+func AllZones() []TimeZone {
+	zones := make([]TimeZone, len(zoneTable))
+	copy(zones, zoneTable)
+
+	return zones
+}
+
+// ExtendedZones returns the zones written with a designation suffix,
+// such as "E*" for GMT +5:30, "E†" for GMT +5:45, and M followed by two
+// prime marks for GMT +14.
+func ExtendedZones() []TimeZone {
+	zones := make([]TimeZone, len(extendedZoneTable))
+	copy(zones, extendedZoneTable)
+
+	return zones
+}
+
+// ZoneByDesignator returns the time zone for a designation such as "R",
+// "E*" or "M'". The letter is case-insensitive. Juliet is not reported
+// since it does not have a fixed offset.
+func ZoneByDesignator(designator string) (TimeZone, bool) {
+	if designator == "" {
+		return TimeZone{}, false
+	}
+
+	return zoneByDesignator(rune(designator[0]), designator[1:])
+}
+
+// zoneByDesignator returns the time zone for a letter and suffix.
+func zoneByDesignator(letter rune, suffix string) (TimeZone, bool) {
+	if suffix == "" {
+		return ZoneByLetter(letter)
+	}
+
+	if letter >= 'a' && letter <= 'z' {
+		letter -= 'a' - 'A'
+	}
+
+	for _, tz := range extendedZoneTable {
+		if tz.letter == letter && tz.suffix == suffix {
+			return tz, true
+		}
+	}
+
+	return TimeZone{}, false
+}
+
+// ZoneByLetter returns the time zone for the designation letter.
+// The letter is case-insensitive. Juliet is not reported since it
+// does not have a fixed offset.
+func ZoneByLetter(letter rune) (TimeZone, bool) {
+	if letter >= 'a' && letter <= 'z' {
+		letter -= 'a' - 'A'
+	}
+
+	tz, ok := timeZones[letter]
+
+	return tz, ok
+}
+
+// ZoneByName returns the time zone for the phonetic name of its
+// designation, such as "ROMEO" or "ECHO*". The name is case-insensitive
+// and "X-RAY" is accepted for XRAY.
+func ZoneByName(name string) (TimeZone, bool) {
+	name = strings.ToUpper(strings.ReplaceAll(name, "-", ""))
+
+	for _, table := range [][]TimeZone{zoneTable, extendedZoneTable} {
+		for _, tz := range table {
+			if tz.name == name {
+				return tz, true
+			}
+		}
+	}
+
+	return TimeZone{}, false
+}
+
+// ZoneByOffset returns the time zone whose offset is the given number
+// of seconds east of UTC/GMT (positive) or west of UTC/GMT (negative).
+// Offsets that are not a whole number of hours, or that lie beyond
+// GMT +12, return one of the ExtendedZones.
+func ZoneByOffset(offset int) (TimeZone, bool) {
+	for _, table := range [][]TimeZone{zoneTable, extendedZoneTable} {
+		for _, tz := range table {
+			if int(tz.offset) == offset {
+				return tz, true
+			}
+		}
+	}
+
+	return TimeZone{}, false
+}
@@ -1 +1,213 @@
 package mildtg
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAllZones(t *testing.T) {
+	t.Parallel()
+
+	zones := AllZones()
+	if len(zones) != 25 {
+		t.Fatalf("got %d zones, want 25", len(zones))
+	}
+
+	seen := make(map[rune]bool)
+	for _, tz := range zones {
+		if tz.Letter() == juliet {
+			t.Errorf("got juliet, want it excluded")
+		}
+
+		if seen[tz.Letter()] {
+			t.Errorf("got duplicate zone %v", tz)
+		}
+
+		seen[tz.Letter()] = true
+	}
+
+	// Modifying the returned slice must not affect the package table.
+	zones[0] = YANKEE
+	if AllZones()[0] != ZULU {
+		t.Errorf("got %v, want %v", AllZones()[0], ZULU)
+	}
+}
+
+func TestZoneByLetter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input rune
+		want  TimeZone
+		ok    bool
+	}{
+		{name: "zulu", input: 'Z', want: ZULU, ok: true},
+		{name: "romeo", input: 'R', want: ROMEO, ok: true},
+		{name: "lowercase", input: 'm', want: MIKE, ok: true},
+		{name: "juliet", input: 'J', want: TimeZone{}, ok: false},
+		{name: "not a letter", input: '1', want: TimeZone{}, ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ZoneByLetter(tt.input)
+			if ok != tt.ok || got != tt.want {
+				t.Errorf("got %v, %v, want %v, %v", got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestZoneByName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  TimeZone
+		ok    bool
+	}{
+		{name: "romeo", input: "ROMEO", want: ROMEO, ok: true},
+		{name: "lowercase", input: "zulu", want: ZULU, ok: true},
+		{name: "x-ray", input: "X-Ray", want: XRAY, ok: true},
+		{name: "xray", input: "XRAY", want: XRAY, ok: true},
+		{name: "letter only", input: "R", want: TimeZone{}, ok: false},
+		{name: "unknown", input: "EASTERN", want: TimeZone{}, ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ZoneByName(tt.input)
+			if ok != tt.ok || got != tt.want {
+				t.Errorf("got %v, %v, want %v, %v", got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestZoneByOffset(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input int
+		want  TimeZone
+		ok    bool
+	}{
+		{name: "zulu", input: 0, want: ZULU, ok: true},
+		{name: "romeo", input: int(-5 * time.Hour / time.Second), want: ROMEO, ok: true},
+		{name: "mike", input: int(12 * time.Hour / time.Second), want: MIKE, ok: true},
+		{name: "yankee", input: int(-12 * time.Hour / time.Second), want: YANKEE, ok: true},
+		{name: "half hour", input: int(330 * time.Minute / time.Second), want: extendedZoneTable[2], ok: true},
+		{name: "negative half hour", input: int(-150 * time.Minute / time.Second), want: extendedZoneTable[11], ok: true},
+		{name: "line islands", input: int(14 * time.Hour / time.Second), want: extendedZoneTable[10], ok: true},
+		{name: "no zone", input: int(75 * time.Minute / time.Second), want: TimeZone{}, ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ZoneByOffset(tt.input)
+			if ok != tt.ok || got != tt.want {
+				t.Errorf("got %v, %v, want %v, %v", got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestZoneByDesignator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		input  string
+		offset int
+		ok     bool
+	}{
+		{name: "romeo", input: "R", offset: -5 * 3600, ok: true},
+		{name: "echo asterisk", input: "E*", offset: 5*3600 + 30*60, ok: true},
+		{name: "lowercase papa asterisk", input: "p*", offset: -3*3600 - 30*60, ok: true},
+		{name: "echo dagger", input: "E†", offset: 5*3600 + 45*60, ok: true},
+		{name: "mike dagger", input: "M†", offset: 12*3600 + 45*60, ok: true},
+		{name: "mike prime", input: "M'", offset: 13 * 3600, ok: true},
+		{name: "mike double prime", input: "M''", offset: 14 * 3600, ok: true},
+		{name: "unassigned suffix", input: "Z*", ok: false},
+		{name: "juliet", input: "J", ok: false},
+		{name: "empty", input: "", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ZoneByDesignator(tt.input)
+			if ok != tt.ok {
+				t.Fatalf("got %v, want %v", ok, tt.ok)
+			}
+
+			if ok && got.Offset() != tt.offset {
+				t.Errorf("got %v, want %v", got.Offset(), tt.offset)
+			}
+		})
+	}
+}
+
+func TestExtendedZones(t *testing.T) {
+	t.Parallel()
+
+	seen := make(map[int]bool)
+	for _, tz := range append(AllZones(), ExtendedZones()...) {
+		if seen[tz.Offset()] {
+			t.Errorf("got duplicate offset %v for %v", tz.Offset(), tz)
+		}
+
+		seen[tz.Offset()] = true
+
+		if got, ok := ZoneByName(tz.Name()); !ok || got != tz {
+			t.Errorf("got %v, %v, want %v by name %v", got, ok, tz, tz.Name())
+		}
+
+		if got, ok := ZoneByDesignator(tz.String()); !ok || got != tz {
+			t.Errorf("got %v, %v, want %v by designator", got, ok, tz)
+		}
+	}
+}
+
+func TestTimeZone_Accessors(t *testing.T) {
+	t.Parallel()
+
+	if ROMEO.Letter() != 'R' {
+		t.Errorf("got %c, want R", ROMEO.Letter())
+	}
+
+	if ROMEO.Name() != "ROMEO" {
+		t.Errorf("got %v, want ROMEO", ROMEO.Name())
+	}
+
+	if ROMEO.Offset() != -5*3600 {
+		t.Errorf("got %v, want %v", ROMEO.Offset(), -5*3600)
+	}
+
+	name, offset := time.Date(2021, 1, 1, 0, 0, 0, 0, ROMEO.Location()).Zone()
+	if name != "R" || offset != -5*3600 {
+		t.Errorf("got %v %v, want R %v", name, offset, -5*3600)
+	}
+}
+
+func TestTimeZone_Location(t *testing.T) {
+	t.Parallel()
+
+	for _, tz := range append(AllZones(), ExtendedZones()...) {
+		loc := tz.Location()
+		if loc != tz.Location() {
+			t.Errorf("%v: got a new location on each call, want it cached", tz)
+		}
+
+		name, offset := time.Date(2021, 1, 1, 0, 0, 0, 0, loc).Zone()
+		if name != tz.String() || offset != tz.Offset() {
+			t.Errorf("got %v %v, want %v %v", name, offset, tz, tz.Offset())
+		}
+	}
+
+	if JULIET.Location() != time.Local {
+		t.Errorf("got %v, want %v", JULIET.Location(), time.Local)
+	}
+}